@@ -3,7 +3,6 @@ package ContextBus
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/AleckDarcy/ContextBus/background"
 	"github.com/AleckDarcy/ContextBus/configure"
@@ -35,9 +34,14 @@ func FromPayload(ctx context.Context, pay *cb.Payload) (*cb_context.Context, boo
 
 	// todo
 	reqCtx := cb_context.NewRequestContext("", pay.RequestId, pay.ConfigId, nil).SetSpanMetadata(pay.Parent)
-	eveCtx := cb_context.NewEventContext(nil, &cb.PrerequisiteSnapshots{})
 
-	cbCtx := cb_context.NewContext(reqCtx, eveCtx).SetTracer(background.ObservationBus.GetTracer())
+	// restore snapshots witnessed by the caller, events witnessed here are counted in offset snapshots as well,
+	// and returned to the caller by Context.ResponsePayload
+	cfg := configure.Store.GetConfigure(pay.ConfigId)
+	snapshots := cfg.RestoreSnapshots(pay.Snapshots)
+	eveCtx := cb_context.NewEventContext(nil, snapshots).SetOffsetSnapshots(cfg.InitializeOffsetSnapshots(snapshots))
+
+	cbCtx := cb_context.NewContext(reqCtx, eveCtx).SetTracer(background.ObservationBus.GetTracer()).SetContext(ctx)
 
 	// fmt.Printf("retrieved ContextBus context from Payload: %+v\n", cbCtx)
 
@@ -45,7 +49,8 @@ func FromPayload(ctx context.Context, pay *cb.Payload) (*cb_context.Context, boo
 }
 
 // OnSubmission user interface
-func OnSubmission(ctx *cb_context.Context, where *cb.EventWhere, who *cb.EventRecorder, app *cb.EventMessage) {
+// returns the Decision of the fired reaction, nil if no reaction is fired
+func OnSubmission(ctx *cb_context.Context, where *cb.EventWhere, who *cb.EventRecorder, app *cb.EventMessage) *reaction.Decision {
 	ctx.SetTimestamp()
 
 	er := &cb.EventRepresentation{
@@ -63,6 +68,9 @@ func OnSubmission(ctx *cb_context.Context, where *cb.EventWhere, who *cb.EventRe
 		er.What.WithLibrary(reqCtx.GetLib(), reqCtx.GetEventMessage())
 	}
 	// write code base info
+	if codebase := eveCtx.GetCodeInfoBasic(); codebase != nil && where.GetCodebase() == nil {
+		er.Where = (&cb.EventWhere{Codebase: codebase}).Merge(where) // where of the caller may be reused
+	}
 
 	esp := background.EnvironmentProfiler.GetLatest()
 
@@ -79,12 +87,14 @@ func OnSubmission(ctx *cb_context.Context, where *cb.EventWhere, who *cb.EventRe
 	}
 
 	cfg := configure.Store.GetConfigure(reqCtx.GetConfigureID())
-	snapshots := cfg.UpdateSnapshots(who.GetName(), eveCtx.GetPrerequisiteSnapshots())
-	offset := eveCtx.GetOffsetSnapshots()
+	snapshots, offset := eveCtx.GetPrerequisiteSnapshots(), eveCtx.GetOffsetSnapshots()
 	if offset != nil {
-		offset = cfg.UpdateSnapshots(who.GetName(), offset)
+		snapshots, offset = cfg.UpdateBothSnapshots(who.GetName(), er.What, snapshots, offset)
+	} else {
+		snapshots = cfg.UpdateSnapshots(who.GetName(), er.What, snapshots)
 	}
 
+	var pcs []uintptr // stacktrace symbolized on the bus
 	if obs := cfg.GetObservationConfigure(who.GetName()); obs != nil {
 		// fmt.Printf("found observation configure for %s\n", who.GetName())
 
@@ -111,32 +121,77 @@ func OnSubmission(ctx *cb_context.Context, where *cb.EventWhere, who *cb.EventRe
 			ed.PrevEventData = prevED
 		}
 
-		obs.Prepare(ctx, ed)
+		if pcs = obs.Prepare(ctx, ed); pcs != nil {
+			er.Where = (&cb.EventWhere{}).Merge(er.Where) // stacktrace is written on the bus, where of the caller may be reused
+		}
 		if ed.SpanMetadata != nil {
 			ctx.SetSpanMetadata(ed.SpanMetadata)
 		}
 	}
 
+	var decision *reaction.Decision
+	var fired *reaction.Configure            // fired in the request, for Reactor.ReactAsync on the bus
+	var busSnapshot *cb.PrerequisiteSnapshot // checked on the bus after observation
 	if rac := cfg.GetReaction(who.Name); rac != nil {
-		if snapshot := snapshots.GetPrerequisiteSnapshot(who.Name); snapshot != nil {
+		if snapshot := snapshots.GetPrerequisiteSnapshot(who.Name); snapshot == nil {
+
+		} else if rac.PreTree.AfterObservation {
+			busSnapshot = snapshot.Clone()
+		} else {
 			if ok, err := rac.PreTree.Check((*reaction.PrerequisiteSnapshot)(snapshot)); err != nil {
 
-			} else if !ok {
+			} else if !rac.Accomplish(ok, (*reaction.PrerequisiteSnapshot)(snapshot)) {
 
+			} else if !rac.Fire(ctx.GetTimestamp()) {
+				// skipped by firing policy
+			} else if !rac.Audit(ed, snapshot, ctx.GetTimestamp()) {
+				// dry run, see reaction.AuditTrail
 			} else {
 				fmt.Println("prerequisites accomplished")
 
-				switch rac.Type {
-				case cb.ReactionType_ReactionFaultDelay:
-					params := rac.Params.(*cb.ReactionConfigure_FaultDelay).FaultDelay
-
-					time.Sleep(time.Duration(params.Ms) * time.Millisecond)
-					fmt.Println("slept for", params.Ms, "ms")
-				}
+				decision, fired = rac.React(ctx, ed), rac
 			}
 		}
 	}
 
 	// push EventData to bus
-	background.ObservationBus.OnSubmit(ctx, cfg, ed)
+	background.ObservationBus.OnSubmit(ctx, cfg, ed, pcs, busSnapshot, fired)
+
+	// panic after the EventData is pushed to bus
+	if crash, ok := decision.GetErr().(*reaction.FaultCrash); ok {
+		panic(crash)
+	}
+
+	return decision
+}
+
+func init() {
+	reaction.SetSyntheticSubmitter(submitSynthetic)
+}
+
+// submitSynthetic submits the synthetic event {who} of ReactionEmitEvent,
+// it returns nil if the submission loops, see cb_context.Context.EnterSynthetic.
+func submitSynthetic(ctx *cb_context.Context, where *cb.EventWhere, who *cb.EventRecorder, app *cb.EventMessage) *reaction.Decision {
+	if !ctx.EnterSynthetic(who.Name) { // loops
+		return nil
+	}
+	defer ctx.ExitSynthetic()
+
+	return OnSubmission(ctx, where, who, app)
+}
+
+// RecoverFaultCrash recovers from the panic raised by ReactionFaultCrash and writes it to {err},
+// other panics are re-raised.
+// It must be deferred directly, e.g., defer ContextBus.RecoverFaultCrash(&err)
+func RecoverFaultCrash(err *error) {
+	if r := recover(); r != nil {
+		crash, ok := r.(*reaction.FaultCrash)
+		if !ok {
+			panic(r)
+		}
+
+		if err != nil {
+			*err = crash
+		}
+	}
 }
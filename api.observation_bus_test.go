@@ -3,10 +3,16 @@ package ContextBus
 import (
 	"github.com/AleckDarcy/ContextBus/background"
 	"github.com/AleckDarcy/ContextBus/configure"
+	"github.com/AleckDarcy/ContextBus/configure/reaction"
 	"github.com/AleckDarcy/ContextBus/context"
 	"github.com/AleckDarcy/ContextBus/helper"
 	cb "github.com/AleckDarcy/ContextBus/proto"
 
+	"github.com/golang/protobuf/proto"
+
+	std_context "context"
+	"encoding/json"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -99,13 +105,13 @@ func TestObservationBus_Reaction(t *testing.T) {
 	ss := cfg.InitializeSnapshots()
 	t.Log(ss)
 
-	cfg.UpdateSnapshots("EventA", ss)
+	cfg.UpdateSnapshots("EventA", nil, ss)
 	t.Log(ss)
 
-	cfg.UpdateSnapshots("EventB", ss)
+	cfg.UpdateSnapshots("EventB", nil, ss)
 	t.Log(ss)
 
-	cfg.UpdateSnapshots("EventC", ss)
+	cfg.UpdateSnapshots("EventC", nil, ss)
 	t.Log(ss)
 
 	reqCtx := context.NewRequestContext("rest", 0, id, rest)
@@ -124,10 +130,587 @@ func TestObservationBus_Reaction(t *testing.T) {
 
 		// initialize PrerequisiteSnapshot for each submission
 
-		OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventC"}, app)
+		err := func() (err error) {
+			defer RecoverFaultCrash(&err)
+
+			OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventC"}, app)
+
+			return nil
+		}()
+		if crash, ok := err.(*reaction.FaultCrash); !ok || crash.Name != "EventC" {
+			t.Error("fault crash expected, err:", err)
+		}
 
 		time.Sleep(time.Millisecond * 500)
 	}
 
 	time.Sleep(time.Second * 2)
 }
+
+func TestObservationBus_Reaction_FaultDrop(t *testing.T) {
+	id := int64(5)
+	configure.Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventB": {
+				Type: cb.ReactionType_ReactionFaultDrop,
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteMessageNode(0, "EventA",
+							cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), -1, nil),
+					},
+				},
+			},
+		},
+	})
+	cfg := configure.Store.GetConfigure(id)
+
+	app := new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path})
+
+	// EventA = 1
+	ctx := context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	decision := OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app)
+	if !ctx.IsDropped() {
+		t.Error("request should be dropped")
+	} else if !decision.IsDrop() || decision.GetName() != "EventB" {
+		t.Error("unexpected decision:", decision)
+	} else if _, ok := decision.GetErr().(*reaction.FaultDrop); !ok {
+		t.Error("fault drop expected, err:", decision.GetErr())
+	}
+
+	// EventA = 2
+	ctx = context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	decision = OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app)
+	if ctx.IsDropped() {
+		t.Error("request should not be dropped")
+	} else if decision.IsFired() {
+		t.Error("unexpected decision:", decision)
+	}
+}
+
+func TestObservationBus_Reaction_FiringPolicy(t *testing.T) {
+	id := int64(6)
+	configure.Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventB": {
+				Type: cb.ReactionType_ReactionFaultDrop,
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteMessageNode(0, "EventA",
+							cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), -1, nil),
+					},
+				},
+				Policy: &cb.FiringPolicy{TotalFires: 2},
+			},
+		},
+	})
+	cfg := configure.Store.GetConfigure(id)
+
+	app := new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path})
+
+	// only the first 2 requests are dropped
+	for i, exp := range []bool{true, true, false} {
+		ctx := context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+		OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+		decision := OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app)
+		if ctx.IsDropped() != exp || decision.IsDrop() != exp {
+			t.Error("fail, request:", i, "dropped:", ctx.IsDropped(), "decision:", decision)
+		}
+	}
+
+	if _, total := cfg.GetReaction("EventB").Budget.Remaining(time.Now().UnixNano()); total != 0 {
+		t.Error("fail, remaining:", total)
+	}
+}
+
+func TestObservationBus_Reaction_FaultDelay(t *testing.T) {
+	id := int64(7)
+	configure.Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventB": {
+				Type: cb.ReactionType_ReactionFaultDelay,
+				Params: &cb.ReactionConfigure_FaultDelay{FaultDelay: &cb.FaultDelayParam{
+					Distribution: cb.DelayDistribution_DelayUniform, Min: 50, Max: 100, Seed: 1,
+				}},
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteMessageNode(0, "EventA",
+							cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), -1, nil),
+					},
+				},
+			},
+		},
+	})
+	cfg := configure.Store.GetConfigure(id)
+
+	app := new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path})
+
+	// delayed within [50, 100] ms
+	ctx := context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	start := time.Now()
+	decision := OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app)
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > 200*time.Millisecond {
+		t.Error("fail, elapsed:", elapsed)
+	} else if decision.GetType() != cb.ReactionType_ReactionFaultDelay || decision.GetErr() != nil {
+		t.Error("unexpected decision:", decision)
+	}
+
+	// interrupted by the request's context.Context
+	reqCtx, cancel := std_context.WithTimeout(std_context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	ctx = context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots())).SetContext(reqCtx)
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	start = time.Now()
+	decision = OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app)
+	if elapsed := time.Since(start); elapsed > 45*time.Millisecond {
+		t.Error("fail, elapsed:", elapsed)
+	} else if decision.GetErr() != std_context.DeadlineExceeded {
+		t.Error("unexpected decision:", decision)
+	}
+}
+
+func TestObservationBus_Reaction_Custom(t *testing.T) {
+	go background.ObservationBus.Run(&configure.ServerConfigure{
+		ServiceName:         "test",
+		EnvironmentProfiler: true,
+		ObservationBus:      true,
+	}, make(chan struct{}))
+
+	errCustom := errors.New("custom reaction")
+	async := make(chan string, 1)
+	reaction.RegisterCustom("api_test", &reaction.ReactorFuncs{
+		Sync: func(ctx *context.Context, rac *reaction.Configure, ed *cb.EventData) *reaction.Decision {
+			return rac.NewDecision(errCustom)
+		},
+		Async: func(ctx *context.Context, rac *reaction.Configure, ed *cb.EventData) {
+			async <- ed.Event.Recorder.Name
+		},
+	})
+
+	id := int64(8)
+	configure.Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventB": {
+				Type:   cb.ReactionType_ReactionCustom,
+				Params: &cb.ReactionConfigure_Custom{Custom: &cb.CustomParam{Name: "api_test"}},
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteMessageNode(0, "EventA",
+							cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), -1, nil),
+					},
+				},
+			},
+		},
+	})
+	cfg := configure.Store.GetConfigure(id)
+
+	app := new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path})
+
+	ctx := context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	decision := OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app)
+	if decision.GetType() != cb.ReactionType_ReactionCustom || decision.GetErr() != errCustom {
+		t.Error("unexpected decision:", decision)
+	}
+
+	select {
+	case name := <-async:
+		if name != "EventB" {
+			t.Error("fail, async reacted on:", name)
+		}
+	case <-time.After(time.Second * 2):
+		t.Error("fail, async reactor not called")
+	}
+}
+
+func TestObservationBus_Reaction_DryRun(t *testing.T) {
+	id := int64(9)
+	pre := &cb.PrerequisiteTree{
+		Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteMessageNode(0, "EventA",
+				cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), -1, nil),
+		},
+	}
+	configure.Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventB": {Type: cb.ReactionType_ReactionFaultDrop, PreTree: pre, DryRun: true},
+			"EventC": {Type: cb.ReactionType_ReactionFaultDrop, PreTree: pre},
+		},
+	})
+	cfg := configure.Store.GetConfigure(id)
+
+	app := new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path})
+
+	// audited without dropping
+	reqID := uint64(901)
+	ctx := context.NewContext(context.NewRequestContext("rest", reqID, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	decision := OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app)
+	if ctx.IsDropped() || decision.IsFired() {
+		t.Error("fail, dropped in dry run, decision:", decision)
+	}
+
+	// audited and dropped
+	decision = OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventC"}, app)
+	if !ctx.IsDropped() || !decision.IsDrop() {
+		t.Error("fail, not dropped, decision:", decision)
+	}
+
+	records := reaction.AuditTrail.Query(reaction.AuditByRequest(reqID))
+	if len(records) != 2 {
+		t.Fatal("fail, records:", records)
+	} else if rec := records[0]; rec.Name != "EventB" || !rec.DryRun || rec.EveID == 0 || len(rec.Snapshot) != 1 {
+		t.Error("fail, record:", rec)
+	} else if rec = records[1]; rec.Name != "EventC" || rec.DryRun || rec.Type != cb.ReactionType_ReactionFaultDrop {
+		t.Error("fail, record:", rec)
+	}
+}
+
+func TestObservationBus_Reaction_FiringMode(t *testing.T) {
+	reaction.RegisterCustom("api_test_mode", &reaction.ReactorFuncs{})
+
+	id := int64(10)
+	pre := &cb.PrerequisiteTree{
+		Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteMessageNode(0, "EventA",
+				cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), -1, nil),
+		},
+	}
+	custom := &cb.ReactionConfigure_Custom{Custom: &cb.CustomParam{Name: "api_test_mode"}}
+	configure.Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventB": {Type: cb.ReactionType_ReactionCustom, Params: custom, PreTree: pre, Mode: cb.FiringMode_FiringOnce},
+			"EventC": {Type: cb.ReactionType_ReactionCustom, Params: custom, PreTree: pre, Mode: cb.FiringMode_FiringLatched},
+		},
+	})
+	cfg := configure.Store.GetConfigure(id)
+
+	app := new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path})
+
+	// EventA = 1
+	ctx := context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	if !OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app).IsFired() {
+		t.Error("fail, EventB not fired")
+	} else if OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app).IsFired() {
+		t.Error("fail, EventB fired twice")
+	} else if !OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventC"}, app).IsFired() {
+		t.Error("fail, EventC not fired")
+	}
+
+	// the accomplished state is sent to the next service
+	buf, err := proto.Marshal(ctx.Payload())
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+	pay := &cb.Payload{}
+	if err = proto.Unmarshal(buf, pay); err != nil {
+		t.Fatal("fail, err:", err)
+	}
+	next, ok := FromPayload(std_context.Background(), pay)
+	if !ok {
+		t.Fatal("fail, context not restored")
+	}
+
+	// EventA = 2
+	OnSubmission(next, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	if OnSubmission(next, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app).IsFired() {
+		t.Error("fail, EventB fired in the next service")
+	} else if !OnSubmission(next, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventC"}, app).IsFired() {
+		t.Error("fail, EventC not latched in the next service")
+	}
+}
+
+func TestObservationBus_Reaction_EmitEvent(t *testing.T) {
+	id := int64(11)
+	count := func(name string, op cb.ConditionOperator) *cb.PrerequisiteTree {
+		return &cb.PrerequisiteTree{
+			Nodes: []*cb.PrerequisiteNode{
+				cb.NewPrerequisiteMessageNode(0, name,
+					cb.NewConditionTree([]*cb.ConditionNode{cb.NewConditionMessageNode(cb.ConditionType_NumOfInvok, op, 1)}, nil), -1, nil),
+			},
+		}
+	}
+	emit := func(name string) *cb.ReactionConfigure_EmitEvent {
+		return &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{Name: name}}
+	}
+	configure.Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			// EventA = 1 derives EventB_derived, which drops the request
+			"EventB":         {Type: cb.ReactionType_ReactionEmitEvent, Params: emit("EventB_derived"), PreTree: count("EventA", cb.ConditionOperator_EQ)},
+			"EventB_derived": {Type: cb.ReactionType_ReactionFaultDrop, PreTree: count("EventB_derived", cb.ConditionOperator_EQ)},
+
+			// loops, stopped by EnterSynthetic
+			"EventC": {Type: cb.ReactionType_ReactionEmitEvent, Params: emit("EventD"), PreTree: count("EventC", cb.ConditionOperator_GE)},
+			"EventD": {Type: cb.ReactionType_ReactionEmitEvent, Params: emit("EventC"), PreTree: count("EventD", cb.ConditionOperator_GE)},
+		},
+	})
+	cfg := configure.Store.GetConfigure(id)
+
+	app := new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path})
+
+	ctx := context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	decision := OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app)
+	if !ctx.IsDropped() || !decision.IsDrop() || decision.GetName() != "EventB_derived" {
+		t.Error("fail, decision:", decision)
+	}
+
+	// EventC -> EventD (synthetic) -> EventC (synthetic) -> EventD (stopped)
+	ctx = context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	decision = OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventC"}, app)
+	if decision.GetType() != cb.ReactionType_ReactionEmitEvent {
+		t.Error("fail, decision:", decision)
+	} else if snapshots := ctx.GetEventContext().GetPrerequisiteSnapshots(); snapshots.Snapshots["EventC"].Value[0] != 2 ||
+		snapshots.Snapshots["EventD"].Value[0] != 1 {
+		t.Error("fail, snapshots:", snapshots)
+	}
+}
+
+func TestPayload_Propagation(t *testing.T) {
+	reaction.RegisterCustom("api_test_edge", &reaction.ReactorFuncs{})
+
+	id := int64(12)
+	configure.Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventC": {
+				Type: cb.ReactionType_ReactionFaultDrop,
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteLogicNode(0, cb.LogicType_And_, -1, []int64{1, 2}),
+						cb.NewPrerequisiteMessageNode(1, "EventA",
+							cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), 0, nil),
+						cb.NewPrerequisiteMessageNode(2, "EventB",
+							cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), 0, nil),
+					},
+				},
+			},
+			"EventF": {
+				Type: cb.ReactionType_ReactionFaultDrop,
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteSequenceNode(0, []string{"EventD", "EventE"}, -1, -1),
+					},
+				},
+			},
+			// EventA = 1 or EventA = 3
+			"EventG": {
+				Type:   cb.ReactionType_ReactionCustom,
+				Params: &cb.ReactionConfigure_Custom{Custom: &cb.CustomParam{Name: "api_test_edge"}},
+				Mode:   cb.FiringMode_FiringEdge,
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteMessageNode(0, "EventA", cb.NewConditionTree([]*cb.ConditionNode{
+							cb.NewConditionLogicNode(cb.LogicType_Or_, -1, []int64{1, 2}),
+							cb.NewConditionMessageNode(cb.ConditionType_NumOfInvok, cb.ConditionOperator_EQ, 1),
+							cb.NewConditionMessageNode(cb.ConditionType_NumOfInvok, cb.ConditionOperator_EQ, 3),
+						}, nil), -1, nil),
+					},
+				},
+			},
+		},
+	})
+	cfg := configure.Store.GetConfigure(id)
+
+	app := new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path})
+
+	// mocked network
+	send := func(pay *cb.Payload) *cb.Payload {
+		buf, err := proto.Marshal(pay)
+		if err != nil {
+			t.Fatal("fail, err:", err)
+		}
+
+		recv := &cb.Payload{}
+		if err = proto.Unmarshal(buf, recv); err != nil {
+			t.Fatal("fail, err:", err)
+		}
+
+		return recv
+	}
+	callee := func(pay *cb.Payload, handler func(ctx *context.Context)) *cb.Payload {
+		ctx, ok := FromPayload(std_context.Background(), send(pay))
+		if !ok {
+			t.Fatal("fail, context not restored")
+		}
+		handler(ctx)
+
+		return send(ctx.ResponsePayload())
+	}
+
+	// caller (EventA) -> callee -> callee of callee (EventB)
+	ctx := context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	rsp := callee(ctx.Payload(), func(ctx *context.Context) {
+		rsp := callee(ctx.Payload(), func(ctx *context.Context) {
+			OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB"}, app)
+		})
+		ctx.MergePayload(rsp)
+	})
+	if rsp.MType != cb.MessageType_Message_Response || rsp.Snapshots != nil {
+		t.Error("fail, payload:", rsp)
+	} else if addition := rsp.Addition.GetPrerequisiteSnapshot("EventC"); addition == nil || addition.Value[1] != 0 || addition.Value[2] != 1 {
+		t.Error("fail, addition:", rsp.Addition)
+	}
+
+	ctx.MergePayload(rsp)
+	if decision := OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventC"}, app); !decision.IsDrop() {
+		t.Error("fail, decision:", decision, "snapshots:", ctx.GetEventContext().GetPrerequisiteSnapshots())
+	}
+
+	// sequence across services: caller (EventD) -> callee (EventE)
+	ctx = context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventD"}, app)
+	ctx.MergePayload(callee(ctx.Payload(), func(ctx *context.Context) {
+		OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventE"}, app)
+	}))
+	if decision := OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventF"}, app); !decision.IsDrop() {
+		t.Error("fail, decision:", decision, "snapshots:", ctx.GetEventContext().GetPrerequisiteSnapshots())
+	}
+
+	// sequence state of the callee replaces the partial match of the caller: caller (EventD) -> callee (EventD, EventE)
+	ctx = context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventD"}, app)
+	rsp = callee(ctx.Payload(), func(ctx *context.Context) {
+		OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventD"}, app)
+		OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventE"}, app)
+	})
+	if addition := rsp.Addition.GetPrerequisiteSnapshot("EventF"); addition == nil || addition.Value[0] != 2 || addition.Clock != 3 || addition.Order[0] != 3 {
+		t.Error("fail, addition:", rsp.Addition)
+	}
+
+	ctx.MergePayload(rsp)
+	if snapshot := ctx.GetEventContext().GetPrerequisiteSnapshots().GetPrerequisiteSnapshot("EventF"); snapshot.Value[0] != 2 || snapshot.Clock != 3 {
+		t.Error("fail, snapshot:", snapshot)
+	}
+
+	// rising edges across services: caller (EventA = 1, fired) -> callee (EventA = 2, reset) -> caller (EventA = 3, fired)
+	ctx = context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	fires := 0
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	if OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventG"}, app).IsFired() {
+		fires++
+	}
+	ctx.MergePayload(callee(ctx.Payload(), func(ctx *context.Context) {
+		OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+		if OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventG"}, app).IsFired() {
+			fires++
+		}
+	}))
+	OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventA"}, app)
+	if OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventG"}, app).IsFired() {
+		fires++
+	}
+	if fires != 2 {
+		t.Error("fail, fires:", fires, "snapshots:", ctx.GetEventContext().GetPrerequisiteSnapshots())
+	}
+}
+
+func TestObservationBus_Stacktrace(t *testing.T) {
+	go background.ObservationBus.Run(&configure.ServerConfigure{
+		ServiceName:         "test",
+		EnvironmentProfiler: true,
+		ObservationBus:      true,
+	}, make(chan struct{}))
+
+	async := make(chan *cb.EventData, 1)
+	reaction.RegisterCustom("api_test_stacktrace", &reaction.ReactorFuncs{
+		Async: func(ctx *context.Context, rac *reaction.Configure, ed *cb.EventData) {
+			async <- ed
+		},
+	})
+
+	id := int64(13)
+	configure.Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventA": {
+				Type:   cb.ReactionType_ReactionCustom,
+				Params: &cb.ReactionConfigure_Custom{Custom: &cb.CustomParam{Name: "api_test_stacktrace"}},
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteMessageNode(0, "EventA",
+							cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), -1, nil),
+					},
+				},
+			},
+			// EventB emits EventB_derived, which has no stacktrace of its own
+			"EventB": {
+				Type:   cb.ReactionType_ReactionEmitEvent,
+				Params: &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{Name: "EventB_derived"}},
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteMessageNode(0, "EventB",
+							cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), -1, nil),
+					},
+				},
+			},
+			"EventB_derived": {
+				Type:   cb.ReactionType_ReactionCustom,
+				Params: &cb.ReactionConfigure_Custom{Custom: &cb.CustomParam{Name: "api_test_stacktrace"}},
+				PreTree: &cb.PrerequisiteTree{
+					Nodes: []*cb.PrerequisiteNode{
+						cb.NewPrerequisiteMessageNode(0, "EventB_derived",
+							cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_0_2_0}, nil), -1, nil),
+					},
+				},
+			},
+		},
+		Observations: map[string]*cb.ObservationConfigure{
+			"EventA": {
+				Logging: &cb.LoggingConfigure{
+					Stacktrace: &cb.StackTraceConfigure{Switch: true},
+					Out:        cb.LogOutType_LogOutType_, // omit print
+				},
+			},
+			"EventB": {
+				Logging: &cb.LoggingConfigure{
+					Stacktrace: &cb.StackTraceConfigure{Switch: true},
+					Out:        cb.LogOutType_LogOutType_, // omit print
+				},
+			},
+		},
+	})
+	cfg := configure.Store.GetConfigure(id)
+
+	app := new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path})
+	where := &cb.EventWhere{}
+
+	ctx := context.NewContext(context.NewRequestContext("rest", 0, id, rest), context.NewEventContext(nil, cfg.InitializeSnapshots()))
+	OnSubmission(ctx, where, &cb.EventRecorder{Name: "EventA"}, app)
+
+	select {
+	case ed := <-async:
+		frames := []map[string]string{}
+		if err := json.Unmarshal([]byte(ed.Event.Where.Stacktrace), &frames); err != nil {
+			t.Fatal("fail, err:", err, "stacktrace:", ed.Event.Where.Stacktrace)
+		} else if len(frames) == 0 || frames[0]["func"] != "TestObservationBus_Stacktrace" {
+			t.Error("fail, stacktrace does not begin at the caller of OnSubmission:", frames)
+		}
+	case <-time.After(time.Second * 2):
+		t.Fatal("fail, async reactor not called")
+	}
+
+	if where.Stacktrace != "" {
+		t.Error("fail, where of the caller modified")
+	}
+
+	// the synthetic event does not share the where of its trigger
+	OnSubmission(ctx, where, &cb.EventRecorder{Name: "EventB"}, app)
+
+	select {
+	case ed := <-async:
+		if ed.Event.Recorder.Name != "EventB_derived" || ed.Event.Where == where || ed.Event.Where.Stacktrace != "" {
+			t.Error("fail, synthetic event:", ed.Event)
+		}
+	case <-time.After(time.Second * 2):
+		t.Fatal("fail, async reactor not called")
+	}
+
+	if where.Stacktrace != "" {
+		t.Error("fail, where of the caller modified")
+	}
+}
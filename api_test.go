@@ -13,7 +13,6 @@ import (
 
 	"errors"
 	"fmt"
-	"sync"
 	"testing"
 	"time"
 )
@@ -69,50 +68,13 @@ type response struct {
 	cbPayload *cb.Payload
 }
 
-type prerequisiteSnapshotsStore struct {
-	lock sync.RWMutex
-
-	map_ map[string]*cb.PrerequisiteSnapshots
-}
-
-var PrerequisiteSnapshotsStore = &prerequisiteSnapshotsStore{
-	map_: map[string]*cb.PrerequisiteSnapshots{},
-}
-
-func (s *prerequisiteSnapshotsStore) Set(uuid string, store *cb.PrerequisiteSnapshots) {
-	s.lock.Lock()
-	s.map_[uuid] = store
-	s.lock.Unlock()
-}
-
-func (s *prerequisiteSnapshotsStore) Get(uuid string) (*cb.PrerequisiteSnapshots, bool) {
-	s.lock.RLock()
-	ss, ok := s.map_[uuid]
-	s.lock.RUnlock()
-
-	return ss, ok
-}
-
-func (s *prerequisiteSnapshotsStore) Delete(uuid string) {
-	s.lock.Lock()
-	delete(s.map_, uuid)
-	s.lock.Unlock()
-}
-
 var requestNetwork = make(chan *request, 1)
 var responseNetwork = make(chan *response, 1)
 
 // mocked blocked inter-service call
 func sendRequest(ctx *context.Context, uid string, req *request) (*response, error) {
-	PrerequisiteSnapshotsStore.Set(uid, ctx.GetEventContext().GetPrerequisiteSnapshots())
-
-	req.cbPayload = &cb.Payload{
-		RequestId: 0,
-		ConfigId:  ctx.GetRequestContext().GetConfigureID(),
-		Snapshots: ctx.GetEventContext().GetPrerequisiteSnapshots().Clone(),
-		MType:     cb.MessageType_Message_Request,
-		Uuid:      uid,
-	}
+	req.cbPayload = ctx.Payload()
+	req.cbPayload.Uuid = uid
 
 	fmt.Println("uuid sent", req.cbPayload.Uuid)
 	fmt.Println(req.cbPayload.Snapshots)
@@ -124,15 +86,9 @@ func sendRequest(ctx *context.Context, uid string, req *request) (*response, err
 	case rsp := <-responseNetwork:
 		fmt.Println("uuid recv", rsp.cbPayload.Uuid)
 
-		ss, ok := PrerequisiteSnapshotsStore.Get(rsp.cbPayload.Uuid)
-		fmt.Println(ss)
-		if ok {
-			ss.MergeOffset(rsp.cbPayload.Snapshots)
-		}
-
 		// TODO do reaction
 
-		ctx.GetEventContext().SetPrerequisiteSnapshots(ss)
+		ctx.MergePayload(rsp.cbPayload)
 
 		return rsp, nil
 	case <-time.After(time.Second):
@@ -401,15 +357,8 @@ func TestObservation(t *testing.T) {
 		app5 := new(cb.EventMessage).SetMessage("send response to %s").SetPaths([]*cb.Path{cb.Test_Path_Rest_From})
 		OnSubmission(ctx, &cb.EventWhere{}, &cb.EventRecorder{Name: "EventB-ends"}, app5)
 
-		rsp = &response{
-			cbPayload: &cb.Payload{
-				RequestId: req.cbPayload.RequestId,
-				ConfigId:  req.cbPayload.ConfigId,
-				Snapshots: ctx.GetEventContext().GetOffsetSnapshots(),
-				MType:     cb.MessageType_Message_Response,
-				Uuid:      req.cbPayload.Uuid,
-			},
-		}
+		rsp = &response{cbPayload: ctx.ResponsePayload()}
+		rsp.cbPayload.Uuid = req.cbPayload.Uuid
 
 		return
 	}
@@ -422,7 +371,7 @@ func TestObservation(t *testing.T) {
 		cfg := configure.Store.GetConfigure(id)
 		ctx1 := new(context.Context).
 			SetRequestContext(context.NewRequestContext("rest", 0, id, rest2).SetSpanMetadata(sm)).
-			SetEventContext(new(context.EventContext).SetPrerequisiteSnapshots(req.cbPayload.Snapshots).SetOffsetSnapshots(cfg.InitializeSnapshots())).
+			SetEventContext(new(context.EventContext).SetPrerequisiteSnapshots(cfg.RestoreSnapshots(req.cbPayload.Snapshots)).SetOffsetSnapshots(cfg.InitializeSnapshots())).
 			SetTracer(background.ObservationBus.GetTracer())
 		rsp, err := handler2(ctx1, req)
 		_ = err
@@ -453,7 +402,7 @@ func TestObservation(t *testing.T) {
 		uid := uuid.New().String()
 		t.Logf("send request to handler2, snapshots: %+v", ctx.GetEventContext().GetPrerequisiteSnapshots())
 		rsp2, err2 := sendRequest(ctx, uid, &request{})
-		t.Logf("receive response from handler2, snapshots: %+v (offset), %+v (updated)", rsp2.cbPayload.Snapshots, ctx.GetEventContext().GetPrerequisiteSnapshots())
+		t.Logf("receive response from handler2, snapshots: %+v (offset), %+v (updated)", rsp2.cbPayload.Addition, ctx.GetEventContext().GetPrerequisiteSnapshots())
 		_, _ = rsp2, err2
 
 		ctx.GetEventContext().SetCodeInfoBasic(&cb.CodeBaseInfo{Name: "handler1", File: "path/file1.go", Line: 155})
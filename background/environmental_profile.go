@@ -1,6 +1,7 @@
 package background
 
 import (
+	"github.com/AleckDarcy/ContextBus/configure/reaction"
 	"github.com/AleckDarcy/ContextBus/helper"
 	cb "github.com/AleckDarcy/ContextBus/proto"
 
@@ -143,6 +144,8 @@ func (e *environmentProfiler) GetEnvironmentProfile() *cb.EnvironmentalProfile {
 	e.store[ep.Timestamp] = ep
 	e.lock.Unlock()
 
+	reaction.ProfileStore.Set(ep) // for PrerequisiteProfile_ nodes
+
 	//fmt.Println("GetEnvironmentProfile()")
 
 	return ep
@@ -2,14 +2,12 @@ package background
 
 import (
 	// Context Bus
-	"os"
-
 	"github.com/AleckDarcy/ContextBus/configure"
 	"github.com/AleckDarcy/ContextBus/configure/observation"
+	"github.com/AleckDarcy/ContextBus/configure/reaction"
 	"github.com/AleckDarcy/ContextBus/context"
 	"github.com/AleckDarcy/ContextBus/helper"
 	cb "github.com/AleckDarcy/ContextBus/proto"
-	"github.com/rs/zerolog"
 
 	// third-party
 	"github.com/AleckDarcy/ContextBus/third-party/github.com/opentracing/opentracing-go"
@@ -24,9 +22,12 @@ import (
 
 // EventDataPayload is the package of event data inside LockFreeQueue
 type EventDataPayload struct {
-	ctx *context.Context
-	cfg *configure.Configure
-	ed  *cb.EventData
+	ctx      *context.Context
+	cfg      *configure.Configure
+	ed       *cb.EventData
+	pcs      []uintptr                // stacktrace captured in OnSubmission, symbolized on the bus
+	snapshot *cb.PrerequisiteSnapshot // for reactions checked after observation
+	fired    *reaction.Configure      // reaction fired inside OnSubmission, for Reactor.ReactAsync
 }
 
 type observationBus struct {
@@ -49,11 +50,14 @@ func (b *observationBus) NewEventID() uint64 {
 	return atomic.AddUint64(&b.eveID, 1)
 }
 
-func (b *observationBus) OnSubmit(ctx *context.Context, cfg *configure.Configure, ed *cb.EventData) {
+func (b *observationBus) OnSubmit(ctx *context.Context, cfg *configure.Configure, ed *cb.EventData, pcs []uintptr, snapshot *cb.PrerequisiteSnapshot, fired *reaction.Configure) {
 	b.queue.Enqueue(&EventDataPayload{
-		ctx: ctx,
-		cfg: cfg,
-		ed:  ed,
+		ctx:      ctx,
+		cfg:      cfg,
+		ed:       ed,
+		pcs:      pcs,
+		snapshot: snapshot,
+		fired:    fired,
 	})
 
 	// try to invoke
@@ -67,10 +71,6 @@ func (b *observationBus) OnSubmit(ctx *context.Context, cfg *configure.Configure
 	}
 }
 
-var todoLoggingConfigure = &observation.LoggingConfigure{
-	Out: cb.LogOutType_Stdout,
-}
-
 func (b *observationBus) doObservation() (cnt, cntL, cntT, cntM int) {
 	for {
 		v, ok := b.queue.Dequeue()
@@ -84,78 +84,40 @@ func (b *observationBus) doObservation() (cnt, cntL, cntT, cntM int) {
 		}
 
 		pay := v.(*EventDataPayload)
+		observation.Symbolize(pay.ed, pay.pcs)
+
 		var cntL_, cntT_, cntM_ int
 		if cfg := pay.cfg; cfg != nil {
+			// check after observation alerts
+			var after *reaction.Configure
+			if rac := cfg.GetReaction(pay.ed.Event.Recorder.Name); rac != nil && pay.snapshot != nil {
+				if ok, err := rac.PreTree.CheckAfterObservation((*reaction.PrerequisiteSnapshot)(pay.snapshot), pay.ed); err != nil {
+					fmt.Println("check prerequisites after observation fail:", err)
+				} else if now := time.Now().UnixNano(); ok && rac.Fire(now) && rac.Audit(pay.ed, pay.snapshot, now) {
+					after = rac
+				}
+			}
+
 			if obs := cfg.GetObservationConfigure(pay.ed.Event.Recorder.Name); obs != nil {
 				cntL_, cntT_, cntM_ = obs.Do(pay.ctx, pay.ed)
+
+				// emit the event chain of bad requests, unless a ReactionPrintLog of the event emits it
+				tail := (*observation.TailLoggingConfigure)(obs.Tail)
+				if reason := tail.Check(pay.ed); reason != "" && !isPrintLog(pay.fired) && !isPrintLog(after) {
+					cntL_ += tail.Do(pay.ed, reason, EnvironmentProfiler.GetByID)
+				}
+
 				cntL += cntL_
 				cntT += cntT_
 				cntM += cntM_
 			}
 
-			// check after observation alerts
-			if rac := cfg.GetReaction(pay.ed.Event.Recorder.Name); rac != nil {
-				if rac.Type == cb.ReactionType_ReactionPrintLog { // todo
-					prevName := rac.PreTree.Nodes[0].PrevEvent.GetName()
-					prevED := pay.ed.GetPreviousEventData(prevName)
-					if prevED != nil {
-						latency := (pay.ed.Event.When.Time - prevED.Event.When.Time) / int64(time.Millisecond)
-						if latency > rac.PreTree.Nodes[0].PrevEvent.GetLatency() {
-							pay.ctx.GetRequestContext()
-							tags := map[string]interface{}{
-								"RequestID": pay.ed.GetMetadata().ReqId,
-								"EventID":   pay.ed.GetMetadata().EveId,
-							}
-
-							span := pay.ctx.GetTracer().StartSpan(prevName, opentracing.StartTime(time.Unix(0, prevED.Event.When.Time)), opentracing.Tags(tags))
-							span.FinishWithOptions(opentracing.FinishOptions{FinishTime: time.Unix(0, pay.ed.Event.When.Time)})
-
-							// print logs in reversed order
-							fmt.Printf("report high latency %d ms, from %s to %s, tags %v\n", latency, prevName, pay.ed.Event.Recorder.Name, tags)
-							todoLoggingConfigure.Do(pay.ed)
-
-							esp := int64(-1)
-
-							buf := make([]byte, 512)
-							for prevED != nil {
-								buf = buf[0:0]
-								if prevED.Metadata.Esp != esp {
-									es := EnvironmentProfiler.GetByID(prevED.Metadata.Esp)
-									esp = prevED.Metadata.Esp
-
-									if es != nil {
-										encoder := helper.JSONEncoder
-										buf = encoder.BeginObject(buf)
-
-										buf = helper.JSONEncoder.AppendKey(buf, "caller")
-										buf = helper.JSONEncoder.AppendString(buf, "environmental profile")
-
-										buf = helper.JSONEncoder.AppendKey(buf, "level")
-										buf = helper.JSONEncoder.AppendString(buf, "warn")
-
-										buf = helper.JSONEncoder.AppendKey(buf, "time")
-
-										buf = helper.JSONEncoder.BeginString(buf)
-										buf = time.Unix(0, esp).AppendFormat(buf, helper.TIME_FORMAT_DEFAULT)
-										buf = helper.JSONEncoder.EndString(buf)
-
-										buf = encoder.AppendKey(buf, "message")
-										buf = encoder.AppendString(buf, fmt.Sprintf("%v", es))
-										buf = helper.JSONEncoder.AppendKey(buf, "ID")
-										buf = helper.JSONEncoder.AppendIDs(buf, pay.ed.GetMetadata().ReqId, pay.ed.GetMetadata().EveId)
-										buf = encoder.EndObject(buf)
-
-										str := helper.BytesToString(buf)
-										fmt.Fprintln(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}, str)
-									}
-								}
-
-								todoLoggingConfigure.Do(prevED)
-								prevED = prevED.PrevEventData
-							}
-						}
-					}
-				}
+			if pay.fired != nil {
+				pay.fired.ReactAsync(pay.ctx, pay.ed)
+			}
+
+			if after != nil {
+				after.ReactAsync(pay.ctx, pay.ed)
 			}
 		}
 
@@ -180,6 +142,47 @@ func (b *observationBus) doObservation() (cnt, cntL, cntT, cntM int) {
 	}
 }
 
+func init() {
+	reaction.Register(cb.ReactionType_ReactionPrintLog, &reaction.ReactorFuncs{Async: printLog})
+}
+
+// isPrintLog returns true if {rac} is a ReactionPrintLog, which emits the event chain by itself
+func isPrintLog(rac *reaction.Configure) bool {
+	return rac != nil && rac.Type == cb.ReactionType_ReactionPrintLog
+}
+
+// printLog reports the latency of event pairs and emits the event chain with tail logging,
+// it is the Reactor of ReactionPrintLog.
+func printLog(ctx *context.Context, rac *reaction.Configure, ed *cb.EventData) {
+	tags := map[string]interface{}{
+		"RequestID": ed.GetMetadata().ReqId,
+		"EventID":   ed.GetMetadata().EveId,
+	}
+
+	for _, prevEvent := range rac.PreTree.PrevEvents {
+		prevName := prevEvent.GetName()
+		prevED := ed.GetPreviousEventData(prevName)
+		if prevED == nil {
+			continue
+		}
+
+		latency := (ed.Event.When.Time - prevED.Event.When.Time) / int64(time.Millisecond)
+
+		span := ctx.GetTracer().StartSpan(prevName, opentracing.StartTime(time.Unix(0, prevED.Event.When.Time)), opentracing.Tags(tags))
+		span.FinishWithOptions(opentracing.FinishOptions{FinishTime: time.Unix(0, ed.Event.When.Time)})
+
+		fmt.Printf("report high latency %d ms, from %s to %s, tags %v\n", latency, prevName, ed.Event.Recorder.Name, tags)
+	}
+
+	// emit the event chain with the tail logging of the event, from the Configure observing it
+	tail := observation.DefaultTailLogging
+	if rac.Tail != nil {
+		tail = (*observation.TailLoggingConfigure)(rac.Tail)
+	}
+
+	tail.Do(ed, observation.TailPrerequisite, EnvironmentProfiler.GetByID)
+}
+
 type observationCounter struct {
 	payload int
 }
@@ -211,6 +214,9 @@ func (b *observationBus) Run(cfg *configure.ServerConfigure, sig chan struct{})
 		select {
 		case <-sig:
 			closer.Close()
+			if err := reaction.CloseNotifiers(); err != nil {
+				fmt.Println("close notifiers fail:", err)
+			}
 
 			return
 		case <-b.signal: // triggered by collector notification
@@ -0,0 +1,76 @@
+package background
+
+import (
+	"github.com/AleckDarcy/ContextBus/configure"
+	"github.com/AleckDarcy/ContextBus/configure/observation"
+	"github.com/AleckDarcy/ContextBus/configure/reaction"
+	"github.com/AleckDarcy/ContextBus/helper"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestObservationBus_TailOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tail.log")
+	tail := &cb.TailLoggingConfigure{
+		Logging: &cb.LoggingConfigure{Out: cb.LogOutType_File, File: &cb.FileConfigure{Path: path}},
+		Slow:    1,
+	}
+	if err := configure.Store.SetConfigure(200, &cb.Configure{
+		Observations: map[string]*cb.ObservationConfigure{
+			"EventA": {Type: cb.ObservationType_ObservationSingle, Tail: tail},
+		},
+	}); err != nil {
+		t.Fatal("fail, err:", err)
+	}
+	cfg := configure.Store.GetConfigure(200)
+
+	printLog := &reaction.Configure{
+		Name:    "EventA",
+		Type:    cb.ReactionType_ReactionPrintLog,
+		PreTree: &reaction.PrerequisiteTree{},
+		Tail:    tail,
+	}
+
+	// a slow request, the chain is emitted by the tail check, or by printLog if it fires
+	start := time.Now().UnixNano()
+	newEventData := func(reqID uint64) *cb.EventData {
+		return &cb.EventData{
+			Event: &cb.EventRepresentation{
+				When:     &cb.EventWhen{Time: start + int64(10*time.Millisecond)},
+				Recorder: &cb.EventRecorder{Name: "EventA"},
+				What:     new(cb.EventWhat),
+			},
+			Metadata: &cb.EventMetadata{ReqId: reqID, EveId: 2},
+			PrevEventData: &cb.EventData{
+				Event:    &cb.EventRepresentation{When: &cb.EventWhen{Time: start}, What: new(cb.EventWhat)},
+				Metadata: &cb.EventMetadata{ReqId: reqID, EveId: 1},
+			},
+		}
+	}
+
+	bus := &observationBus{queue: helper.NewLockFreeQueue(), signal: make(chan struct{}, 1)}
+	bus.OnSubmit(nil, cfg, newEventData(1), nil, nil, nil)
+	bus.OnSubmit(nil, cfg, newEventData(2), nil, nil, printLog)
+	bus.doObservation()
+
+	if err := observation.FileWriterStore.Close(); err != nil {
+		t.Fatal("fail, err:", err)
+	}
+	log, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	// one chain per request
+	if cnt := strings.Count(string(log), "triggered by "+observation.TailSlow); cnt != 1 {
+		t.Error("fail, slow chains:", cnt)
+	}
+	if cnt := strings.Count(string(log), "triggered by "+observation.TailPrerequisite); cnt != 1 {
+		t.Error("fail, prerequisite chains:", cnt)
+	}
+}
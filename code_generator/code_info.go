@@ -1,6 +1,8 @@
 package code_generator
 
 import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
 	"runtime"
 	"sync"
 )
@@ -45,7 +47,8 @@ type CodeInfoBasic struct {
 
 type CodeInfo struct {
 	sync.Once
-	basic CodeInfoBasic
+	basic    CodeInfoBasic
+	codebase *cb.CodeBaseInfo
 }
 
 // getCodeInfoBasic gets static code information such as file and line where (re-written) observation happens.
@@ -70,7 +73,15 @@ func (f *codeInfoStorage) GetCodeInfoBasic(id CodeInfoID, skip int) *CodeInfoBas
 	info := &f.infos[id]
 	info.Once.Do(func() {
 		info.basic = *getCodeInfoBasic(skip)
+		info.codebase = &cb.CodeBaseInfo{Name: info.basic.name, File: info.basic.file, Line: int64(info.basic.line)}
 	})
 
 	return &info.basic
 }
+
+// GetCodeBaseInfo returns the CodeBaseInfo indexed by CodeInfoID for EventContext.SetCodeInfoBasic, shared by all calls
+func (f *codeInfoStorage) GetCodeBaseInfo(id CodeInfoID, skip int) *cb.CodeBaseInfo {
+	f.GetCodeInfoBasic(id, skip+1)
+
+	return f.infos[id].codebase
+}
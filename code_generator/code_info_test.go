@@ -1,6 +1,7 @@
 package code_generator
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -9,6 +10,15 @@ func TestGetCodeInfoBasic(t *testing.T) {
 	t.Logf("%+v", info)
 }
 
+func TestGetCodeBaseInfo(t *testing.T) {
+	codebase := CodeInfoStorage.GetCodeBaseInfo(CodeInfo_Test, CodeInfoSkip)
+	if !strings.HasSuffix(codebase.File, "code_generator/code_info_test.go") || codebase.Line == 0 || !strings.HasPrefix(codebase.Name, "github.com/AleckDarcy/ContextBus/code_generator.") {
+		t.Error("fail, codebase:", codebase)
+	} else if CodeInfoStorage.GetCodeBaseInfo(CodeInfo_Test, CodeInfoSkip) != codebase {
+		t.Error("fail, codebase is not shared")
+	}
+}
+
 func BenchmarkGetCodeInfoBasic_Generated(b *testing.B) {
 	fc := func() {
 		info := CodeInfoStorage.GetCodeInfoBasic(CodeInfo_Test, CodeInfoSkip+1)
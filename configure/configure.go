@@ -6,8 +6,10 @@ import (
 	"github.com/AleckDarcy/ContextBus/helper"
 	cb "github.com/AleckDarcy/ContextBus/proto"
 
+	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 )
 
@@ -26,11 +28,11 @@ const CBCID_LOGGINGYPASS = int64(1)
 const CBCID_DEFAULT = int64(0)
 const CBCID_OBSERVATIONBYPASS = int64(-1)
 
-// DefaultJSONLogging prints Message to Stdout
-// todo Logging Type: JSON, text, xml, etc...
+// DefaultJSONLogging prints Message to Stdout as JSON lines
 var DefaultJSONLogging = &cb.LoggingConfigure{
 	Timestamp: &cb.TimestampConfigure{Format: helper.TIME_FORMAT_DEFAULT},
 	Out:       cb.LogOutType_Stdout,
+	Encoder:   cb.LogEncoderType_JSONLines,
 }
 
 // DefaultObservation converts event data to a single log entry
@@ -39,9 +41,12 @@ var DefaultObservation = &cb.ObservationConfigure{
 	Logging: DefaultJSONLogging,
 }
 
-func (s *store) convertConfigure(cfg *cb.Configure) *Configure {
+// convertConfigure returns an error if a reaction can not be built, e.g., the Notifier of ReactionEmail
+func (s *store) convertConfigure(cfg *cb.Configure) (*Configure, error) {
 	racs := map[string]*reaction.Configure(nil)
 	racMapMap := map[string]map[string]*reaction.Configure{} // <event (observation), <event (reaction), cfg>>
+	racSeqs := []*reaction.Configure(nil)
+	winIndex := map[string]struct{}{}
 
 	if reactions := cfg.Reactions; reactions != nil {
 		racs = make(map[string]*reaction.Configure, len(reactions))
@@ -51,9 +56,30 @@ func (s *store) convertConfigure(cfg *cb.Configure) *Configure {
 				Type:    reaction_.Type,
 				Params:  reaction_.Params,
 				PreTree: reaction.NewPrerequisiteTree(reaction_.PreTree),
+				Budget:  reaction.NewBudget(reaction_.Policy),
+				Delayer: reaction.NewDelayer(reaction_.GetFaultDelay()),
+				Tail:    cfg.GetObservations()[name].GetTail(),
+				DryRun:  cfg.DryRun || reaction_.DryRun,
+				Mode:    reaction_.Mode,
 			}
+			notifier, err := reaction.NewNotifier(reaction_.GetEmail())
+			if err != nil {
+				return nil, fmt.Errorf("reactions[%q]: %s", name, err)
+			}
+			rac.Notifier = notifier
 			racs[name] = rac
 
+			for _, window := range rac.PreTree.Windows {
+				reaction.WindowStore.Register(window)
+				winIndex[window] = struct{}{}
+			}
+
+			if rac.PreTree.IsSequence() {
+				racSeqs = append(racSeqs, rac)
+
+				continue
+			}
+
 			for _, node := range reaction_.PreTree.Nodes {
 				if node.Type == cb.PrerequisiteNodeType_PrerequisiteMessage_ {
 					racMap, ok := racMapMap[node.Message.Name]
@@ -78,30 +104,63 @@ func (s *store) convertConfigure(cfg *cb.Configure) *Configure {
 	}
 
 	return &Configure{
-		Reactions:     racs,
-		Observations:  cfg.Observations,
-		ReactionIndex: racIndex,
-	}
+		Reactions:         racs,
+		Observations:      cfg.Observations,
+		ReactionIndex:     racIndex,
+		SequenceReactions: racSeqs,
+		WindowIndex:       winIndex,
+	}, nil
 }
 
 // SetDefault Configure
-// atomic supports real-time updates
-func (s *store) SetDefault(configure *cb.Configure) {
-	cfg := s.convertConfigure(configure)
+// atomic supports real-time updates,
+// it returns an error and keeps the previous one if {configure} can not be converted
+func (s *store) SetDefault(configure *cb.Configure) error {
+	cfg, err := s.convertConfigure(configure)
+	if err != nil {
+		return err
+	}
 
 	atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&s.defaultConfigure)), unsafe.Pointer(cfg))
+
+	return nil
+}
+
+// SetDefaultChecked is SetDefault that rejects invalid {configure}, see Validate
+func (s *store) SetDefaultChecked(configure *cb.Configure) error {
+	if err := Validate(configure); err != nil {
+		return err
+	}
+
+	return s.SetDefault(configure)
 }
 
 func (s *store) GetDefault() *Configure {
 	return (*Configure)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&s.defaultConfigure))))
 }
 
-func (s *store) SetConfigure(id int64, configure *cb.Configure) {
-	cfg := s.convertConfigure(configure)
+// SetConfigure sets the Configure of {id},
+// it returns an error and keeps the previous one if {configure} can not be converted
+func (s *store) SetConfigure(id int64, configure *cb.Configure) error {
+	cfg, err := s.convertConfigure(configure)
+	if err != nil {
+		return err
+	}
 
 	s.lock.Lock()
 	s.configures[id] = cfg
 	s.lock.Unlock()
+
+	return nil
+}
+
+// SetConfigureChecked is SetConfigure that rejects invalid {configure}, see Validate
+func (s *store) SetConfigureChecked(id int64, configure *cb.Configure) error {
+	if err := Validate(configure); err != nil {
+		return err
+	}
+
+	return s.SetConfigure(id, configure)
 }
 
 func (s *store) GetConfigure(id int64) *Configure {
@@ -127,26 +186,76 @@ func (c *Configure) InitializeSnapshots() *cb.PrerequisiteSnapshots {
 	return &cb.PrerequisiteSnapshots{Snapshots: ss}
 }
 
-func (c *Configure) UpdateSnapshots(name string, ss *cb.PrerequisiteSnapshots) *cb.PrerequisiteSnapshots {
+// RestoreSnapshots returns snapshots restored from {ss} witnessed by another service,
+// snapshots missing from {ss} or mismatching the reactions are initialized.
+func (c *Configure) RestoreSnapshots(ss *cb.PrerequisiteSnapshots) *cb.PrerequisiteSnapshots {
+	restored := c.InitializeSnapshots()
+	for name, snapshot := range restored.Snapshots {
+		if src := ss.GetPrerequisiteSnapshot(name); src != nil && len(src.Value) == len(snapshot.Value) && len(src.Order) == len(snapshot.Order) {
+			src = src.Clone()
+			src.Sequence = snapshot.Sequence
+			restored.Snapshots[name] = src
+		}
+	}
+
+	return restored
+}
+
+// InitializeOffsetSnapshots returns offset snapshots of a callee restored with {ss},
+// PrerequisiteSequence_ nodes start from the state of {ss} and are returned in full to the caller,
+// see cb.PrerequisiteSnapshot.MergeOffset.
+func (c *Configure) InitializeOffsetSnapshots(ss *cb.PrerequisiteSnapshots) *cb.PrerequisiteSnapshots {
+	offset := c.InitializeSnapshots()
+	for name, snapshot := range offset.Snapshots {
+		snapshot.CopySequence(ss.GetPrerequisiteSnapshot(name))
+	}
+
+	return offset
+}
+
+// UpdateWindows counts the invocation of event {name} in reaction.WindowStore
+func (c *Configure) UpdateWindows(name string) {
+	if _, ok := c.WindowIndex[name]; ok {
+		reaction.WindowStore.Add(name, time.Now().UnixNano())
+	}
+}
+
+// UpdateSnapshots updates snapshots of the current request and reaction.WindowStore of the service,
+// with the invocation of event {name} carrying values {what}.
+func (c *Configure) UpdateSnapshots(name string, what *cb.EventWhat, ss *cb.PrerequisiteSnapshots) *cb.PrerequisiteSnapshots {
+	c.UpdateWindows(name)
+
 	racs, ok := c.ReactionIndex[name]
 	if ok {
 		for _, rac := range racs {
-			rac.PreTree.UpdateSnapshot(name, (*reaction.PrerequisiteSnapshot)(ss.Snapshots[rac.Name]))
+			rac.PreTree.UpdateSnapshotWithEvent(name, what, (*reaction.PrerequisiteSnapshot)(ss.GetPrerequisiteSnapshot(rac.Name)))
 		}
 	}
 
+	for _, rac := range c.SequenceReactions {
+		rac.PreTree.UpdateSnapshotWithEvent(name, what, (*reaction.PrerequisiteSnapshot)(ss.GetPrerequisiteSnapshot(rac.Name)))
+	}
+
 	return ss
 }
 
-func (c *Configure) UpdateBothSnapshots(name string, ss, offset *cb.PrerequisiteSnapshots) (*cb.PrerequisiteSnapshots, *cb.PrerequisiteSnapshots) {
+// UpdateBothSnapshots is UpdateSnapshots with offset snapshots, the invocation is counted once in reaction.WindowStore.
+func (c *Configure) UpdateBothSnapshots(name string, what *cb.EventWhat, ss, offset *cb.PrerequisiteSnapshots) (*cb.PrerequisiteSnapshots, *cb.PrerequisiteSnapshots) {
+	c.UpdateWindows(name)
+
 	racs, ok := c.ReactionIndex[name]
 	if ok {
 		for _, rac := range racs {
-			rac.PreTree.UpdateSnapshot(name, (*reaction.PrerequisiteSnapshot)(ss.Snapshots[rac.Name]))
-			rac.PreTree.UpdateSnapshot(name, (*reaction.PrerequisiteSnapshot)(offset.Snapshots[rac.Name]))
+			rac.PreTree.UpdateSnapshotWithEvent(name, what, (*reaction.PrerequisiteSnapshot)(ss.GetPrerequisiteSnapshot(rac.Name)))
+			rac.PreTree.UpdateSnapshotWithEvent(name, what, (*reaction.PrerequisiteSnapshot)(offset.GetPrerequisiteSnapshot(rac.Name)))
 		}
 	}
 
+	for _, rac := range c.SequenceReactions {
+		rac.PreTree.UpdateSnapshotWithEvent(name, what, (*reaction.PrerequisiteSnapshot)(ss.GetPrerequisiteSnapshot(rac.Name)))
+		rac.PreTree.UpdateSnapshotWithEvent(name, what, (*reaction.PrerequisiteSnapshot)(offset.GetPrerequisiteSnapshot(rac.Name)))
+	}
+
 	return ss, offset
 }
 
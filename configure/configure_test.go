@@ -1,6 +1,7 @@
 package configure
 
 import (
+	"github.com/AleckDarcy/ContextBus/configure/reaction"
 	cb "github.com/AleckDarcy/ContextBus/proto"
 
 	"testing"
@@ -27,3 +28,76 @@ func TestDefault(t *testing.T) {
 	Store.SetDefault(cfg)
 	t.Log(Store.defaultConfigure)
 }
+
+func TestConfigure_UpdateSnapshots_Sequence(t *testing.T) {
+	cfg, _ := Store.convertConfigure(&cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventC": {
+				Type:    cb.ReactionType_ReactionFaultDrop,
+				PreTree: cb.Test_PrerequisiteTree3,
+			},
+		},
+	})
+
+	if len(cfg.SequenceReactions) != 1 {
+		t.Error("fail, SequenceReactions:", cfg.SequenceReactions)
+	}
+
+	// EventD is not a prerequisite but still counts as an event in between
+	ss := cfg.InitializeSnapshots()
+	for _, name := range []string{"EventA", "EventD", "EventD", "EventB", "EventC"} {
+		cfg.UpdateSnapshots(name, nil, ss)
+	}
+
+	snapshot := ss.GetPrerequisiteSnapshot("EventC")
+	if snapshot.Clock != 5 {
+		t.Error("fail, clock:", snapshot.Clock)
+	}
+
+	if acc, err := cfg.GetReaction("EventC").PreTree.Check((*reaction.PrerequisiteSnapshot)(snapshot)); err != nil || acc {
+		t.Error("fail, err:", err, "acc:", acc)
+	}
+}
+
+func TestConfigure_ReactionTail(t *testing.T) {
+	tail := &cb.TailLoggingConfigure{Slow: 100}
+	cfg, _ := Store.convertConfigure(&cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventA": {Type: cb.ReactionType_ReactionPrintLog, PreTree: cb.Test_PrerequisiteTree0},
+			"EventB": {Type: cb.ReactionType_ReactionPrintLog, PreTree: cb.Test_PrerequisiteTree0},
+		},
+		Observations: map[string]*cb.ObservationConfigure{
+			"EventA": {Tail: tail},
+		},
+	})
+
+	if rac := cfg.GetReaction("EventA"); rac.Tail != tail {
+		t.Error("fail, tail:", rac.Tail)
+	} else if rac = cfg.GetReaction("EventB"); rac.Tail != nil {
+		t.Error("fail, tail:", rac.Tail)
+	}
+}
+
+func TestStore_SetConfigure_Error(t *testing.T) {
+	id := int64(100)
+	if err := Store.SetConfigure(id, &cb.Configure{}); err != nil {
+		t.Fatal("fail, err:", err)
+	}
+	prev := Store.GetConfigure(id)
+
+	// invalid webhook of ReactionEmail
+	err := Store.SetConfigure(id, &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventA": {
+				Type:    cb.ReactionType_ReactionEmail,
+				Params:  &cb.ReactionConfigure_Email{Email: &cb.EmailParam{Webhook: &cb.WebhookParam{}}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+		},
+	})
+	if err == nil {
+		t.Error("fail, error expected")
+	} else if cfg := Store.GetConfigure(id); cfg != prev {
+		t.Error("fail, configure replaced:", cfg)
+	}
+}
@@ -5,17 +5,21 @@ import (
 	"github.com/AleckDarcy/ContextBus/helper"
 	cb "github.com/AleckDarcy/ContextBus/proto"
 	"github.com/AleckDarcy/ContextBus/third-party/github.com/opentracing/opentracing-go"
-	"github.com/rs/zerolog"
+	"github.com/AleckDarcy/ContextBus/third-party/github.com/opentracing/opentracing-go/log"
 
 	"fmt"
 	"os"
 	"time"
 )
 
-// Prepare gets stacktrace and pre-allocates SpanID
-func (c *Configure) Prepare(ctx *context.Context, ed *cb.EventData) {
+// StacktraceSkip is the number of frames skipped by Prepare, the stacktrace begins at the caller of OnSubmission
+const StacktraceSkip = 2
+
+// Prepare captures the stacktrace and pre-allocates SpanID.
+// It returns program counters of the stacktrace to be symbolized on the observation bus by Symbolize, or nil if not required.
+func (c *Configure) Prepare(ctx *context.Context, ed *cb.EventData) (pcs []uintptr) {
 	if c.IsStacktrace(ctx) { // capture stacktrace
-		// todo get stacktrace
+		pcs = helper.CaptureStacktrace(StacktraceSkip)
 	}
 
 	if c.Tracing == nil { // no tracing required
@@ -58,6 +62,8 @@ func (c *Configure) Prepare(ctx *context.Context, ed *cb.EventData) {
 		// fmt.Printf("set span metadata: %s\n", sm.HexString())
 		ed.SpanMetadata = sm
 	}
+
+	return
 }
 
 // IsStacktrace returns true if any observation configuration needs stacktrace
@@ -117,45 +123,73 @@ func (c *TimestampConfigure) Do() {
 	}
 }
 
-func (c *StackTraceConfigure) Do() {
-	if c != nil {
-		// todo default
+// Symbolize fills EventWhere.stacktrace of {ed} with {pcs} captured by Prepare, and recycles {pcs}
+func Symbolize(ed *cb.EventData, pcs []uintptr) {
+	if pcs == nil {
 		return
 	}
+
+	ed.Event.WithWhere(nil).Stacktrace = helper.BytesToString(helper.StacktraceStore.Get(pcs))
+	helper.ReleaseStacktrace(pcs)
 }
 
-func (c *LoggingConfigure) Do(ed *cb.EventData) int {
-	if c == nil {
-		return 0
+// LogRecords returns span logs of stacktraces of {eds} at their time if switched on
+func (c *StackTraceConfigure) LogRecords(eds ...*cb.EventData) []opentracing.LogRecord {
+	if c == nil || !c.Switch {
+		return nil
 	}
 
-	er := ed.Event
+	var records []opentracing.LogRecord
+	for _, ed := range eds {
+		if stack := ed.GetEvent().GetWhere().GetStacktrace(); stack != "" {
+			records = append(records, opentracing.LogRecord{
+				Timestamp: time.Unix(0, ed.Event.When.Time),
+				Fields:    []log.Field{log.String("event", ed.Event.Recorder.GetName()), log.String("stack", stack)},
+			})
+		}
+	}
 
-	e := newEvent()
-	e.buf = helper.JSONEncoder.BeginObject(e.buf)
+	return records
+}
+
+// Do appends the stacktrace of {er} as {key} by {enc} if switched on
+func (c *StackTraceConfigure) Do(enc Encoder, dst []byte, key string, er *cb.EventRepresentation) []byte {
+	if c == nil || !c.Switch {
+		return dst
+	} else if stack := er.GetWhere().GetStacktrace(); stack != "" {
+		dst = enc.AppendRawJSON(dst, key, stack)
+	}
 
-	e.buf = helper.JSONEncoder.AppendKey(e.buf, "caller")
-	e.buf = helper.JSONEncoder.AppendString(e.buf, "test/caller.go")
+	return dst
+}
 
-	e.buf = helper.JSONEncoder.AppendKey(e.buf, "level")
-	e.buf = helper.JSONEncoder.AppendString(e.buf, "info")
+// Severity returns the severity of log entries of {er}: the level configured, or the severity of the recorder, or SeverityInfo
+func (c *LoggingConfigure) Severity(er *cb.EventRepresentation) cb.Severity {
+	return c.Level.Or(er.GetRecorder().GetSeverity()).Or(cb.Severity_SeverityInfo)
+}
 
-	e.buf = helper.JSONEncoder.AppendKey(e.buf, "time")
+// Do emits a log entry of {ed} unless its severity is lower than MinLevel, it returns the number of log entries
+func (c *LoggingConfigure) Do(ed *cb.EventData) int {
+	if c == nil {
+		return 0
+	}
 
-	e.buf = helper.JSONEncoder.BeginString(e.buf)
-	if ts := c.Timestamp; ts == nil {
-		e.buf = time.Unix(0, er.When.Time).AppendFormat(e.buf, helper.TIME_FORMAT_DEFAULT)
-	} else {
-		e.buf = time.Unix(0, er.When.Time).AppendFormat(e.buf, ts.Format)
+	er := ed.Event
+	severity := c.Severity(er)
+	if severity < c.MinLevel {
+		return 0
 	}
-	e.buf = helper.JSONEncoder.EndString(e.buf)
+	enc := c.LogEncoder()
 
-	// do ids
-	e.buf = helper.JSONEncoder.AppendKey(e.buf, "ID")
-	e.buf = helper.JSONEncoder.AppendIDs(e.buf, ed.Metadata.ReqId, ed.Metadata.EveId)
+	e := newEvent()
+	e.buf = enc.Begin(e.buf)
+	e.buf = enc.AppendTime(e.buf, "time", time.Unix(0, er.When.Time), c.timeFormat())
+	e.buf = enc.AppendString(e.buf, "level", severity.Level())
+	if caller := er.GetWhere().GetCodebase().Caller(); caller != "" {
+		e.buf = enc.AppendString(e.buf, "caller", caller)
+	}
 
 	// do message
-	e.buf = helper.JSONEncoder.AppendKey(e.buf, "message")
 	msg := er.What.Application.GetMessage()
 	paths := er.What.Application.GetPaths()
 	values := make([]interface{}, len(paths))
@@ -166,38 +200,48 @@ func (c *LoggingConfigure) Do(ed *cb.EventData) int {
 			values[i] = fmt.Sprintf("!error(%s)", err.Error())
 		}
 	}
-	e.buf = helper.JSONEncoder.AppendString(e.buf, fmt.Sprintf(msg, values...))
+	e.buf = enc.AppendString(e.buf, "message", fmt.Sprintf(msg, values...))
+
+	// do ids
+	e.buf = enc.AppendIDs(e.buf, "ID", ed.GetMetadata().GetReqId(), ed.GetMetadata().GetEveId())
 
 	// do tag
 	if len(c.Attrs) != 0 {
-		e.buf = helper.JSONEncoder.AppendKey(e.buf, "tags")
-		e.buf = DoTagFaster(e.buf, c.Attrs, er)
-
-		//tags := DoTag(c.Attrs, er)
-		//e.buf = encoder.JSONEncoder.AppendTags(e.buf, tags)
+		e.buf = DoTagFaster(enc, e.buf, "tags", c.Attrs, er)
 	}
 
-	e.buf = helper.JSONEncoder.EndObject(e.buf)
+	// do stacktrace
+	e.buf = (*StackTraceConfigure)(c.Stacktrace).Do(enc, e.buf, "stack", er)
+
+	e.buf = enc.End(e.buf)
 	str := string(e.buf)
 	e.finalize()
 
 	(*TimestampConfigure)(c.Timestamp).Do()
-	(*StackTraceConfigure)(c.Stacktrace).Do()
 
+	c.write(str)
+
+	return 1
+}
+
+// write prints the JSON log entry {str} to Out
+func (c *LoggingConfigure) write(str string) {
 	switch c.Out {
 	case cb.LogOutType_LogOutType_:
 		// omit print
 	case cb.LogOutType_Stdout:
-		fmt.Fprintln(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}, str)
+		fmt.Fprintln(os.Stdout, str)
 	case cb.LogOutType_Stderr:
-		fmt.Fprintln(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}, str)
+		fmt.Fprintln(os.Stderr, str)
 	case cb.LogOutType_File:
-
+		if w, err := FileWriterStore.GetFileWriter(c.File); err != nil {
+			fmt.Println("get log file fail:", err)
+		} else if _, err = w.WriteString(str); err != nil {
+			fmt.Println("write log file fail:", err)
+		}
 	default:
 		fmt.Fprintln(os.Stdout, str)
 	}
-
-	return 1
 }
 
 func (c *TracingConfigure) Do(ctx *context.Context, ed *cb.EventData) int {
@@ -233,7 +277,10 @@ func (c *TracingConfigure) Do(ctx *context.Context, ed *cb.EventData) int {
 	// fmt.Printf("span reference %s\n", sm.HexString())
 
 	span := ctx.GetTracer().StartSpan(c.SpanName, sr, opentracing.SpanID(sm.SpanId), opentracing.StartTime(time.Unix(0, prev.Event.When.Time)), opentracing.Tags(tags))
-	span.FinishWithOptions(opentracing.FinishOptions{FinishTime: time.Unix(0, ed.Event.When.Time)})
+	span.FinishWithOptions(opentracing.FinishOptions{
+		FinishTime: time.Unix(0, ed.Event.When.Time),
+		LogRecords: (*StackTraceConfigure)(c.Stacktrace).LogRecords(prev, ed),
+	})
 
 	// fmt.Printf("todo tracing span=%s (from %s to %s)\n", span.Context().(jaeger.SpanContext).ToString(), prev.Event.Recorder.Name, ed.Event.Recorder.Name)
 
@@ -322,17 +369,17 @@ func DoTag(cfg []*cb.AttributeConfigure, er *cb.EventRepresentation) map[string]
 	return tags
 }
 
-func DoTagFaster(dst []byte, cfg []*cb.AttributeConfigure, er *cb.EventRepresentation) []byte {
-	dst = helper.JSONEncoder.BeginObject(dst)
+// DoTagFaster appends tags as {key} by {enc} without building the map of DoTag
+func DoTagFaster(enc Encoder, dst []byte, key string, cfg []*cb.AttributeConfigure, er *cb.EventRepresentation) []byte {
+	dst = enc.BeginTags(dst, key)
 
 	for _, path := range cfg {
 		str, err := er.What.GetValue(path.Path)
 
 		if err == nil {
-			dst = helper.JSONEncoder.AppendKey(dst, path.Name)
-			dst = helper.JSONEncoder.AppendString(dst, str)
+			dst = enc.AppendTag(dst, path.Name, str)
 		}
 	}
 
-	return dst
+	return enc.EndTags(dst)
 }
@@ -0,0 +1,296 @@
+package observation
+
+import (
+	"github.com/AleckDarcy/ContextBus/helper"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Encoder appends a log entry field by field, an entry begins with Begin and ends with End.
+// Tags are appended between BeginTags and EndTags.
+type Encoder interface {
+	Begin(dst []byte) []byte
+	End(dst []byte) []byte
+
+	AppendString(dst []byte, key, value string) []byte
+	AppendTime(dst []byte, key string, t time.Time, format string) []byte
+	AppendIDs(dst []byte, key string, reqID, eveID uint64) []byte
+	AppendRawJSON(dst []byte, key string, raw string) []byte // {raw} is valid JSON
+
+	BeginTags(dst []byte, key string) []byte
+	AppendTag(dst []byte, key, value string) []byte
+	EndTags(dst []byte) []byte
+}
+
+var encoders = struct {
+	lock sync.RWMutex
+	m    map[cb.LogEncoderType]Encoder
+}{
+	m: map[cb.LogEncoderType]Encoder{
+		cb.LogEncoderType_JSONLines: JSONEncoder{},
+		cb.LogEncoderType_Logfmt:    LogfmtEncoder{},
+		cb.LogEncoderType_Console:   ConsoleEncoder{},
+	},
+}
+
+// RegisterEncoder sets the Encoder of {typ}, replacing the built-in one
+func RegisterEncoder(typ cb.LogEncoderType, enc Encoder) {
+	if typ == cb.LogEncoderType_LogEncoderType_ {
+		panic("register encoder of LogEncoderType_")
+	}
+
+	encoders.lock.Lock()
+	encoders.m[typ] = enc
+	encoders.lock.Unlock()
+}
+
+// GetEncoder returns the Encoder of {typ}, or nil if not registered
+func GetEncoder(typ cb.LogEncoderType) Encoder {
+	encoders.lock.RLock()
+	defer encoders.lock.RUnlock()
+
+	return encoders.m[typ]
+}
+
+// LogEncoder returns the Encoder of the logging, Console for Stdout and Stderr if not configured, JSONLines otherwise
+func (c *LoggingConfigure) LogEncoder() Encoder {
+	typ := c.Encoder
+	if typ == cb.LogEncoderType_LogEncoderType_ {
+		if out := c.Out; out == cb.LogOutType_Stdout || out == cb.LogOutType_Stderr {
+			typ = cb.LogEncoderType_Console
+		} else {
+			typ = cb.LogEncoderType_JSONLines
+		}
+	}
+
+	if enc := GetEncoder(typ); enc != nil {
+		return enc
+	}
+
+	return JSONEncoder{}
+}
+
+// timeFormat returns the configured format of timestamps
+func (c *LoggingConfigure) timeFormat() string {
+	if ts := c.Timestamp; ts != nil && ts.Format != "" {
+		return ts.Format
+	}
+
+	return helper.TIME_FORMAT_DEFAULT
+}
+
+// JSONEncoder encodes an entry as a JSON object, i.e., a JSON line
+type JSONEncoder struct{}
+
+func (JSONEncoder) Begin(dst []byte) []byte {
+	return helper.JSONEncoder.BeginObject(dst)
+}
+
+func (JSONEncoder) End(dst []byte) []byte {
+	return helper.JSONEncoder.EndObject(dst)
+}
+
+func (JSONEncoder) AppendString(dst []byte, key, value string) []byte {
+	dst = helper.JSONEncoder.AppendKey(dst, key)
+
+	return helper.JSONEncoder.AppendString(dst, value)
+}
+
+func (JSONEncoder) AppendTime(dst []byte, key string, t time.Time, format string) []byte {
+	dst = helper.JSONEncoder.AppendKey(dst, key)
+
+	return helper.JSONEncoder.AppendTime(dst, t, format)
+}
+
+func (JSONEncoder) AppendIDs(dst []byte, key string, reqID, eveID uint64) []byte {
+	dst = helper.JSONEncoder.AppendKey(dst, key)
+
+	return helper.JSONEncoder.AppendIDs(dst, reqID, eveID)
+}
+
+func (JSONEncoder) AppendRawJSON(dst []byte, key string, raw string) []byte {
+	dst = helper.JSONEncoder.AppendKey(dst, key)
+
+	return append(dst, raw...)
+}
+
+func (JSONEncoder) BeginTags(dst []byte, key string) []byte {
+	dst = helper.JSONEncoder.AppendKey(dst, key)
+
+	return helper.JSONEncoder.BeginObject(dst)
+}
+
+func (e JSONEncoder) AppendTag(dst []byte, key, value string) []byte {
+	return e.AppendString(dst, key, value)
+}
+
+func (JSONEncoder) EndTags(dst []byte) []byte {
+	return helper.JSONEncoder.EndObject(dst)
+}
+
+// LogfmtEncoder encodes an entry as space separated key=value pairs, tags are flattened
+type LogfmtEncoder struct{}
+
+func (LogfmtEncoder) Begin(dst []byte) []byte {
+	return dst
+}
+
+func (LogfmtEncoder) End(dst []byte) []byte {
+	return dst
+}
+
+// appendKey appends {key}= after a space unless {dst} begins an entry
+func (LogfmtEncoder) appendKey(dst []byte, key string) []byte {
+	if len(dst) != 0 {
+		dst = append(dst, ' ')
+	}
+	dst = append(dst, key...)
+
+	return append(dst, '=')
+}
+
+// appendValue quotes {value} if it is empty or contains spaces, quotes, '=' or control characters
+func (LogfmtEncoder) appendValue(dst []byte, value string) []byte {
+	if value == "" || strings.IndexFunc(value, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == '\\' || r == 0x7f
+	}) != -1 {
+		return strconv.AppendQuote(dst, value)
+	}
+
+	return append(dst, value...)
+}
+
+func (e LogfmtEncoder) AppendString(dst []byte, key, value string) []byte {
+	return e.appendValue(e.appendKey(dst, key), value)
+}
+
+func (e LogfmtEncoder) AppendTime(dst []byte, key string, t time.Time, format string) []byte {
+	return e.appendValue(e.appendKey(dst, key), t.Format(format))
+}
+
+func (e LogfmtEncoder) AppendIDs(dst []byte, key string, reqID, eveID uint64) []byte {
+	dst = e.appendKey(dst, "req_id")
+	dst = strconv.AppendUint(dst, reqID, 10)
+	dst = e.appendKey(dst, "eve_id")
+
+	return strconv.AppendUint(dst, eveID, 10)
+}
+
+func (e LogfmtEncoder) AppendRawJSON(dst []byte, key string, raw string) []byte {
+	return e.AppendString(dst, key, raw)
+}
+
+func (LogfmtEncoder) BeginTags(dst []byte, key string) []byte {
+	return dst
+}
+
+func (e LogfmtEncoder) AppendTag(dst []byte, key, value string) []byte {
+	return e.AppendString(dst, key, value)
+}
+
+func (LogfmtEncoder) EndTags(dst []byte) []byte {
+	return dst
+}
+
+// ANSI colors of ConsoleEncoder
+const (
+	colorReset  = "\x1b[0m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+	colorCyan   = "\x1b[36m"
+	colorGray   = "\x1b[90m"
+	colorBold   = "\x1b[1m"
+)
+
+// ConsoleEncoder encodes an entry as colored text like zerolog.ConsoleWriter:
+// time, level, caller and message are printed plainly, other fields as colored key=value pairs
+type ConsoleEncoder struct{}
+
+func (ConsoleEncoder) Begin(dst []byte) []byte {
+	return dst
+}
+
+func (ConsoleEncoder) End(dst []byte) []byte {
+	return dst
+}
+
+func (ConsoleEncoder) appendSep(dst []byte) []byte {
+	if len(dst) != 0 {
+		dst = append(dst, ' ')
+	}
+
+	return dst
+}
+
+func (e ConsoleEncoder) appendColored(dst []byte, color, str string) []byte {
+	dst = append(dst, color...)
+	dst = append(dst, str...)
+
+	return append(dst, colorReset...)
+}
+
+func (e ConsoleEncoder) appendKey(dst []byte, key string) []byte {
+	return e.appendColored(e.appendSep(dst), colorCyan, key+"=")
+}
+
+func (e ConsoleEncoder) AppendString(dst []byte, key, value string) []byte {
+	switch key {
+	case "level":
+		return e.appendLevel(e.appendSep(dst), value)
+	case "caller":
+		dst = e.appendColored(e.appendSep(dst), colorBold, value)
+
+		return e.appendColored(dst, colorCyan, " >")
+	case "message":
+		return append(e.appendSep(dst), value...)
+	}
+
+	return LogfmtEncoder{}.appendValue(e.appendKey(dst, key), value)
+}
+
+// appendLevel appends the abbreviation of {level} in its color
+func (e ConsoleEncoder) appendLevel(dst []byte, level string) []byte {
+	switch level {
+	case "debug":
+		return e.appendColored(dst, colorYellow, "DBG")
+	case "info":
+		return e.appendColored(dst, colorGreen, "INF")
+	case "warn":
+		return e.appendColored(dst, colorRed, "WRN")
+	case "error":
+		return e.appendColored(dst, colorBold+colorRed, "ERR")
+	}
+
+	return append(dst, strings.ToUpper(level)...)
+}
+
+func (e ConsoleEncoder) AppendTime(dst []byte, key string, t time.Time, format string) []byte {
+	return e.appendColored(e.appendSep(dst), colorGray, t.Format(format))
+}
+
+func (e ConsoleEncoder) AppendIDs(dst []byte, key string, reqID, eveID uint64) []byte {
+	dst = append(e.appendKey(dst, "req_id"), strconv.FormatUint(reqID, 10)...)
+
+	return append(e.appendKey(dst, "eve_id"), strconv.FormatUint(eveID, 10)...)
+}
+
+func (e ConsoleEncoder) AppendRawJSON(dst []byte, key string, raw string) []byte {
+	return LogfmtEncoder{}.appendValue(e.appendKey(dst, key), raw)
+}
+
+func (ConsoleEncoder) BeginTags(dst []byte, key string) []byte {
+	return dst
+}
+
+func (e ConsoleEncoder) AppendTag(dst []byte, key, value string) []byte {
+	return LogfmtEncoder{}.appendValue(e.appendKey(dst, key), value)
+}
+
+func (ConsoleEncoder) EndTags(dst []byte) []byte {
+	return dst
+}
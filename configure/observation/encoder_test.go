@@ -0,0 +1,107 @@
+package observation
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// encodeEntry encodes an entry of all fields by {enc}
+func encodeEntry(enc Encoder) string {
+	buf := enc.Begin(nil)
+	buf = enc.AppendTime(buf, "time", time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC), time.RFC3339)
+	buf = enc.AppendString(buf, "level", "info")
+	buf = enc.AppendString(buf, "caller", "test/caller.go")
+	buf = enc.AppendString(buf, "message", "received message from senderA")
+	buf = enc.AppendIDs(buf, "ID", 1, 2)
+	buf = enc.BeginTags(buf, "tags")
+	buf = enc.AppendTag(buf, "from", "senderA")
+	buf = enc.AppendTag(buf, "empty", "")
+	buf = enc.EndTags(buf)
+	buf = enc.AppendRawJSON(buf, "stack", `[{"func":"f1"}]`)
+
+	return string(enc.End(buf))
+}
+
+func TestJSONEncoder(t *testing.T) {
+	got := encodeEntry(JSONEncoder{})
+
+	entry := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(got), &entry); err != nil {
+		t.Fatal("fail, err:", err, "got:", got)
+	}
+
+	if entry["time"] != "2021-01-02T03:04:05Z" || entry["message"] != "received message from senderA" || entry["ID"] != "RequestID 1, EventID 2" {
+		t.Error("fail, entry:", entry)
+	} else if tags, ok := entry["tags"].(map[string]interface{}); !ok || tags["from"] != "senderA" {
+		t.Error("fail, tags:", entry["tags"])
+	} else if stack, ok := entry["stack"].([]interface{}); !ok || len(stack) != 1 {
+		t.Error("fail, stack:", entry["stack"])
+	}
+}
+
+func TestLogfmtEncoder(t *testing.T) {
+	expect := `time=2021-01-02T03:04:05Z level=info caller=test/caller.go message="received message from senderA" req_id=1 eve_id=2 from=senderA empty="" stack="[{\"func\":\"f1\"}]"`
+	if got := encodeEntry(LogfmtEncoder{}); got != expect {
+		t.Errorf("fail, expect: %s, got: %s", expect, got)
+	}
+}
+
+func TestConsoleEncoder(t *testing.T) {
+	got := encodeEntry(ConsoleEncoder{})
+
+	for _, expect := range []string{
+		colorGray + "2021-01-02T03:04:05Z" + colorReset,
+		colorGreen + "INF" + colorReset,
+		colorBold + "test/caller.go" + colorReset,
+		" received message from senderA ",
+		colorCyan + "from=" + colorReset + "senderA",
+	} {
+		if !strings.Contains(got, expect) {
+			t.Errorf("fail, expect: %q, got: %q", expect, got)
+		}
+	}
+}
+
+func TestLoggingConfigure_LogEncoder(t *testing.T) {
+	tests := []struct {
+		cfg *LoggingConfigure
+		enc Encoder
+	}{
+		{&LoggingConfigure{Out: cb.LogOutType_Stdout}, ConsoleEncoder{}},
+		{&LoggingConfigure{Out: cb.LogOutType_Stderr}, ConsoleEncoder{}},
+		{&LoggingConfigure{Out: cb.LogOutType_File}, JSONEncoder{}},
+		{&LoggingConfigure{Out: cb.LogOutType_Stdout, Encoder: cb.LogEncoderType_JSONLines}, JSONEncoder{}},
+		{&LoggingConfigure{Out: cb.LogOutType_File, Encoder: cb.LogEncoderType_Logfmt}, LogfmtEncoder{}},
+		{&LoggingConfigure{Encoder: cb.LogEncoderType(100)}, JSONEncoder{}},
+	}
+
+	for i, test := range tests {
+		if enc := test.cfg.LogEncoder(); enc != test.enc {
+			t.Errorf("fail, test: %d, expect: %T, got: %T", i, test.enc, enc)
+		}
+	}
+}
+
+func BenchmarkLoggingConfigure_Encoder(b *testing.B) {
+	what := new(cb.EventWhat)
+	what.WithApplication(new(cb.EventMessage).SetMessage("received message from %s").SetPaths([]*cb.Path{path}))
+	what.WithLibrary("rest", rest)
+	ed := &cb.EventData{
+		Event:    &cb.EventRepresentation{When: &cb.EventWhen{Time: time.Now().UnixNano()}, What: what},
+		Metadata: &cb.EventMetadata{ReqId: 1, EveId: 2},
+	}
+
+	for _, typ := range []cb.LogEncoderType{cb.LogEncoderType_JSONLines, cb.LogEncoderType_Logfmt, cb.LogEncoderType_Console} {
+		cfg := &LoggingConfigure{Out: cb.LogOutType_LogOutType_, Encoder: typ}
+
+		b.Run(typ.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				cfg.Do(ed)
+			}
+		})
+	}
+}
@@ -0,0 +1,349 @@
+package observation
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultFlushInterval is used if FileConfigure.flush_interval is 0
+const DefaultFlushInterval = time.Second
+
+const (
+	fileBufferSize    = 64 * 1024
+	fileBackupFormat  = "20060102T150405.000000000" // sorted in time order
+	fileCompressedExt = ".gz"
+)
+
+// FileWriter writes log entries to a file of LogOutType File,
+// writes are buffered and flushed by FlushInterval, rotation and retention are done as configured in FileConfigure.
+// It is safe for concurrent use.
+type FileWriter struct {
+	cfg *cb.FileConfigure
+
+	lock    sync.Mutex
+	file    *os.File
+	buf     *bufio.Writer
+	size    int64     // size of file including buf
+	opened  time.Time // when file is opened or rotated
+	flushes *time.Timer
+	mill    sync.WaitGroup // compression and removal of backups
+	millMu  sync.Mutex     // serializes mills
+
+	now func() time.Time
+}
+
+// NewFileWriter returns a FileWriter of {cfg}, the file is opened lazily on the first write
+func NewFileWriter(cfg *cb.FileConfigure) (*FileWriter, error) {
+	if cfg == nil || cfg.Path == "" {
+		return nil, errors.New("file path required")
+	} else if cfg.MaxSize < 0 || cfg.MaxAge < 0 || cfg.MaxBackups < 0 || cfg.FlushInterval < 0 {
+		return nil, errors.New("negative file configure")
+	}
+
+	return &FileWriter{cfg: cfg, now: time.Now}, nil
+}
+
+func (w *FileWriter) flushInterval() time.Duration {
+	if w.cfg.FlushInterval == 0 {
+		return DefaultFlushInterval
+	}
+
+	return time.Duration(w.cfg.FlushInterval) * time.Millisecond
+}
+
+// Write writes {p} as a whole, the file is rotated before if it would exceed MaxSize or is older than MaxAge
+func (w *FileWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	} else if w.expired(int64(len(p))) {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.buf.Write(p)
+	w.size += int64(n)
+
+	if w.flushes == nil {
+		w.flushes = time.AfterFunc(w.flushInterval(), func() {
+			if err := w.Flush(); err != nil {
+				fmt.Println("flush log file fail:", err)
+			}
+		})
+	}
+
+	return n, err
+}
+
+// WriteString writes {str} as a line
+func (w *FileWriter) WriteString(str string) (int, error) {
+	return w.Write([]byte(str + "\n"))
+}
+
+// expired returns true if the file should be rotated before writing {n} bytes
+func (w *FileWriter) expired(n int64) bool {
+	if w.size == 0 {
+		return false
+	} else if w.cfg.MaxSize > 0 && w.size+n > w.cfg.MaxSize {
+		return true
+	} else if w.cfg.MaxAge > 0 && w.now().Sub(w.opened) >= time.Duration(w.cfg.MaxAge)*time.Second {
+		return true
+	}
+
+	return false
+}
+
+// open opens or creates the file for appending
+func (w *FileWriter) open() error {
+	if err := os.MkdirAll(filepath.Dir(w.cfg.Path), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(w.cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+
+		return err
+	}
+
+	w.file, w.size, w.opened = file, info.Size(), w.now()
+	if w.buf == nil {
+		w.buf = bufio.NewWriterSize(file, fileBufferSize)
+	} else {
+		w.buf.Reset(file)
+	}
+
+	return nil
+}
+
+// rotate renames the file to a backup and opens a new one, backups are milled in background
+func (w *FileWriter) rotate() error {
+	if err := w.closeFile(); err != nil {
+		return err
+	}
+
+	backup := w.cfg.Path + "." + w.now().Format(fileBackupFormat)
+	if err := os.Rename(w.cfg.Path, backup); err != nil {
+		return err
+	}
+
+	w.mill.Add(1)
+	go func() {
+		defer w.mill.Done()
+
+		if err := w.millBackups(); err != nil {
+			fmt.Println("mill log file backups fail:", err)
+		}
+	}()
+
+	return w.open()
+}
+
+// closeFile flushes and closes the file
+func (w *FileWriter) closeFile() error {
+	if w.file == nil {
+		return nil
+	}
+
+	err := w.buf.Flush()
+	if cErr := w.file.Close(); err == nil {
+		err = cErr
+	}
+	w.file = nil
+
+	return err
+}
+
+// millBackups removes backups beyond MaxBackups, and compresses the rest if configured.
+// Every mill handles all backups, so mills run in any order.
+func (w *FileWriter) millBackups() error {
+	w.millMu.Lock()
+	defer w.millMu.Unlock()
+
+	backups, err := w.Backups()
+	if err != nil {
+		return err
+	}
+
+	if max := int(w.cfg.MaxBackups); max > 0 && len(backups) > max {
+		for _, backup := range backups[:len(backups)-max] {
+			if err = os.Remove(backup); err != nil {
+				return err
+			}
+		}
+		backups = backups[len(backups)-max:]
+	}
+
+	if w.cfg.Compress {
+		for _, backup := range backups {
+			if strings.HasSuffix(backup, fileCompressedExt) {
+				continue
+			} else if err = compressFile(backup); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Backups returns rotated files in time order
+func (w *FileWriter) Backups() ([]string, error) {
+	dir, prefix := filepath.Dir(w.cfg.Path), filepath.Base(w.cfg.Path)+"."
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		ts := strings.TrimSuffix(name[len(prefix):], fileCompressedExt)
+		if _, err := time.Parse(fileBackupFormat, ts); err == nil {
+			backups = append(backups, filepath.Join(dir, name))
+		}
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return strings.TrimSuffix(backups[i], fileCompressedExt) < strings.TrimSuffix(backups[j], fileCompressedExt)
+	})
+
+	return backups, nil
+}
+
+// compressFile gzips {name} to {name}.gz and removes it
+func compressFile(name string) error {
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(name+fileCompressedExt, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(dst)
+	if _, err = io.Copy(gz, src); err == nil {
+		err = gz.Close()
+	}
+	if cErr := dst.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		os.Remove(name + fileCompressedExt)
+
+		return err
+	}
+
+	return os.Remove(name)
+}
+
+// Flush writes buffered log entries to the file
+func (w *FileWriter) Flush() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.flushes != nil {
+		w.flushes.Stop()
+		w.flushes = nil
+	}
+	if w.file == nil {
+		return nil
+	}
+
+	return w.buf.Flush()
+}
+
+// Close flushes and closes the file, and waits for backups being milled.
+// The file is reopened on the next write.
+func (w *FileWriter) Close() error {
+	w.lock.Lock()
+	if w.flushes != nil {
+		w.flushes.Stop()
+		w.flushes = nil
+	}
+	err := w.closeFile()
+	w.lock.Unlock()
+
+	w.mill.Wait()
+
+	return err
+}
+
+// FileWriterStore keeps FileWriters by path, loggings of the same path share the FileWriter of the first FileConfigure
+var FileWriterStore = &fileWriterStore{writers: map[string]*FileWriter{}}
+
+type fileWriterStore struct {
+	lock    sync.RWMutex
+	writers map[string]*FileWriter
+}
+
+// GetFileWriter returns the FileWriter of {cfg.Path}, created if not existed
+func (s *fileWriterStore) GetFileWriter(cfg *cb.FileConfigure) (*FileWriter, error) {
+	if cfg == nil {
+		return nil, errors.New("nil FileConfigure")
+	}
+
+	s.lock.RLock()
+	w, ok := s.writers[cfg.Path]
+	s.lock.RUnlock()
+	if ok {
+		return w, nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if w, ok = s.writers[cfg.Path]; ok {
+		return w, nil
+	}
+
+	w, err := NewFileWriter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.writers[cfg.Path] = w
+
+	return w, nil
+}
+
+// Close closes all FileWriters, it is expected to be called before the service exits
+func (s *fileWriterStore) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var err error
+	for path, w := range s.writers {
+		if cErr := w.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+		delete(s.writers, path)
+	}
+
+	return err
+}
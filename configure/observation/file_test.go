@@ -0,0 +1,168 @@
+package observation
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func readLogFile(t *testing.T, name string) string {
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if strings.HasSuffix(name, fileCompressedExt) {
+		if r, err = gzip.NewReader(file); err != nil {
+			t.Fatal("fail, err:", err)
+		}
+	}
+
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	return string(buf)
+}
+
+func TestFileWriter_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "cb.log")
+	w, err := NewFileWriter(&cb.FileConfigure{Path: path, MaxSize: 20, MaxBackups: 2, Compress: true})
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	// lines of 10 bytes, 2 lines per file
+	for i := 1; i <= 7; i++ {
+		if _, err = w.WriteString(fmt.Sprintf("line-%04d", i)); err != nil {
+			t.Fatal("fail, err:", err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	if got := readLogFile(t, path); got != "line-0007\n" {
+		t.Errorf("fail, got: %q", got)
+	}
+
+	backups, err := w.Backups()
+	if err != nil || len(backups) != 2 {
+		t.Fatal("fail, backups:", backups, "err:", err)
+	}
+	for i, expect := range []string{"line-0003\nline-0004\n", "line-0005\nline-0006\n"} {
+		if !strings.HasSuffix(backups[i], fileCompressedExt) {
+			t.Error("fail, not compressed:", backups[i])
+		} else if got := readLogFile(t, backups[i]); got != expect {
+			t.Errorf("fail, backup: %d, expect: %q, got: %q", i, expect, got)
+		}
+	}
+}
+
+func TestFileWriter_MaxAge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cb.log")
+	w, _ := NewFileWriter(&cb.FileConfigure{Path: path, MaxAge: 60})
+
+	now := time.Now()
+	w.now = func() time.Time { return now }
+
+	w.WriteString("line-0001")
+	now = now.Add(30 * time.Second)
+	w.WriteString("line-0002")
+	now = now.Add(30 * time.Second)
+	w.WriteString("line-0003")
+	w.Close()
+
+	if got := readLogFile(t, path); got != "line-0003\n" {
+		t.Errorf("fail, got: %q", got)
+	}
+	if backups, _ := w.Backups(); len(backups) != 1 || readLogFile(t, backups[0]) != "line-0001\nline-0002\n" {
+		t.Error("fail, backups:", backups)
+	}
+}
+
+func TestFileWriter_Flush(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cb.log")
+	w, _ := NewFileWriter(&cb.FileConfigure{Path: path, FlushInterval: 50})
+	defer w.Close()
+
+	w.WriteString("line-0001")
+	if got := readLogFile(t, path); got != "" {
+		t.Errorf("fail, flushed before interval: %q", got)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	if got := readLogFile(t, path); got != "line-0001\n" {
+		t.Errorf("fail, got: %q", got)
+	}
+}
+
+func TestFileWriter_Concurrent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cb.log")
+	w, _ := NewFileWriter(&cb.FileConfigure{Path: path, MaxSize: 1000})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				w.WriteString(fmt.Sprintf("worker-%d-%04d", i, j))
+			}
+		}(i)
+	}
+	wg.Wait()
+	w.Close()
+
+	files, _ := w.Backups()
+	files = append(files, path)
+
+	lines := 0
+	for _, file := range files {
+		for _, line := range strings.Split(strings.TrimSuffix(readLogFile(t, file), "\n"), "\n") {
+			if len(line) != len("worker-0-0000") {
+				t.Fatalf("fail, interleaved line: %q", line)
+			}
+			lines++
+		}
+	}
+	if lines != 800 {
+		t.Error("fail, lines:", lines)
+	}
+}
+
+func TestNewFileWriter_Error(t *testing.T) {
+	for i, cfg := range []*cb.FileConfigure{nil, {}, {Path: "cb.log", MaxSize: -1}, {Path: "cb.log", MaxBackups: -1}} {
+		if _, err := NewFileWriter(cfg); err == nil {
+			t.Error("fail, error expected, cfg:", i)
+		}
+	}
+}
+
+func TestLoggingConfigure_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cb.log")
+	defer FileWriterStore.Close()
+
+	c := &LoggingConfigure{Out: cb.LogOutType_File, File: &cb.FileConfigure{Path: path}}
+	if cnt := c.Do(newTailEventData(0, "GET")); cnt != 1 {
+		t.Error("fail, cnt:", cnt)
+	}
+
+	w, _ := FileWriterStore.GetFileWriter(c.File)
+	w.Flush()
+	if got := readLogFile(t, path); !strings.HasPrefix(got, "{") || !strings.HasSuffix(got, "}\n") {
+		t.Errorf("fail, got: %q", got)
+	}
+}
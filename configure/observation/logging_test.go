@@ -2,12 +2,15 @@ package observation
 
 import (
 	cb_context "github.com/AleckDarcy/ContextBus/context"
+	"github.com/AleckDarcy/ContextBus/helper"
 	cb "github.com/AleckDarcy/ContextBus/proto"
 
 	"github.com/rs/zerolog/log"
 
 	"context"
+	"encoding/json"
 	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -74,3 +77,88 @@ func TestLoggingConfigure(t *testing.T) {
 	logCfg := &LoggingConfigure{}
 	logCfg.Do(&cb.EventData{Event: &cb.EventRepresentation{When: &cb.EventWhen{Time: time.Now().UnixNano()}, What: what}})
 }
+
+func TestLoggingConfigure_Severity(t *testing.T) {
+	tests := []struct {
+		cfg      *LoggingConfigure
+		recorder *cb.EventRecorder
+		expect   cb.Severity
+	}{
+		{&LoggingConfigure{}, nil, cb.Severity_SeverityInfo},
+		{&LoggingConfigure{}, &cb.EventRecorder{Severity: cb.Severity_SeverityWarn}, cb.Severity_SeverityWarn},
+		{&LoggingConfigure{Level: cb.Severity_SeverityDebug}, &cb.EventRecorder{Severity: cb.Severity_SeverityWarn}, cb.Severity_SeverityDebug},
+	}
+
+	for i, test := range tests {
+		if got := test.cfg.Severity(&cb.EventRepresentation{Recorder: test.recorder}); got != test.expect {
+			t.Error("fail, test:", i, "expect:", test.expect, "got:", got)
+		}
+	}
+}
+
+func TestLoggingConfigure_LevelAndCaller(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cb.log")
+	defer FileWriterStore.Close()
+
+	c := &LoggingConfigure{Out: cb.LogOutType_File, File: &cb.FileConfigure{Path: path}, MinLevel: cb.Severity_SeverityWarn}
+	newED := func(severity cb.Severity) *cb.EventData {
+		return &cb.EventData{
+			Event: &cb.EventRepresentation{
+				When:     &cb.EventWhen{Time: time.Now().UnixNano()},
+				Where:    &cb.EventWhere{Codebase: &cb.CodeBaseInfo{Name: "handler1", File: "path/file1.go", Line: 140}},
+				Recorder: &cb.EventRecorder{Name: "EventA", Severity: severity},
+				What:     &cb.EventWhat{Application: new(cb.EventMessage).SetMessage("received")},
+			},
+			Metadata: &cb.EventMetadata{ReqId: 1, EveId: 2},
+		}
+	}
+
+	if cnt := c.Do(newED(cb.Severity_Severity_)); cnt != 0 {
+		t.Error("fail, info entry not filtered")
+	}
+	if cnt := c.Do(newED(cb.Severity_SeverityError)); cnt != 1 {
+		t.Error("fail, error entry filtered")
+	}
+
+	w, _ := FileWriterStore.GetFileWriter(c.File)
+	w.Flush()
+
+	entry := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(readLogFile(t, path)), &entry); err != nil {
+		t.Fatal("fail, err:", err)
+	} else if entry["level"] != "error" || entry["caller"] != "path/file1.go:140" {
+		t.Error("fail, entry:", entry)
+	}
+}
+
+func TestStackTraceConfigure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cb.log")
+	defer FileWriterStore.Close()
+
+	ed := newTailEventData(0, "GET")
+	ed.Event.Recorder = &cb.EventRecorder{Name: "EventA"}
+	Symbolize(ed, helper.CaptureStacktrace(0))
+
+	c := &LoggingConfigure{Out: cb.LogOutType_File, File: &cb.FileConfigure{Path: path}, Stacktrace: &cb.StackTraceConfigure{Switch: true}}
+	c.Do(ed)
+
+	w, _ := FileWriterStore.GetFileWriter(c.File)
+	w.Flush()
+
+	entry := struct {
+		Stack []map[string]string `json:"stack"`
+	}{}
+	if err := json.Unmarshal([]byte(readLogFile(t, path)), &entry); err != nil {
+		t.Fatal("fail, err:", err)
+	} else if len(entry.Stack) == 0 || entry.Stack[0]["func"] != "TestStackTraceConfigure" {
+		t.Error("fail, stack:", entry.Stack)
+	}
+
+	records := (*StackTraceConfigure)(c.Stacktrace).LogRecords(ed.PrevEventData, ed)
+	if len(records) != 1 || records[0].Timestamp.UnixNano() != ed.Event.When.Time || records[0].Fields[1].Value() != ed.Event.Where.Stacktrace {
+		t.Error("fail, records:", records)
+	}
+	if records = (*StackTraceConfigure)(nil).LogRecords(ed); records != nil {
+		t.Error("fail, records:", records)
+	}
+}
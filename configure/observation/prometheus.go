@@ -180,6 +180,25 @@ func (s *metricVecStore) Push(pusher *push.Pusher) {
 	s.lock.Unlock()
 }
 
+// HasOpts returns true if the metric vec of {typ} is registered with Opts {id}
+func (s *metricVecStore) HasOpts(typ cb.MetricType, id int64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch typ {
+	case cb.MetricType_Counter:
+		return s.counters[id] != nil
+	case cb.MetricType_Gauge:
+		return s.gauges[id] != nil
+	case cb.MetricType_Histogram:
+		return s.histograms[id] != nil
+	case cb.MetricType_Summary:
+		return s.summaries[id] != nil
+	}
+
+	return false
+}
+
 func (s *metricVecStore) setCounter(id int64, vec *counterVecWrap) {
 	s.counters[id] = vec
 }
@@ -10,6 +10,7 @@ type AttributeConfiguration cb.AttributeConfigure
 type TimestampConfigure cb.TimestampConfigure
 type StackTraceConfigure cb.StackTraceConfigure
 type LoggingConfigure cb.LoggingConfigure
+type TailLoggingConfigure cb.TailLoggingConfigure
 type TracingConfigure cb.TracingConfigure
 type MetricsConfigure cb.MetricsConfigure
 type Configure cb.ObservationConfigure
@@ -0,0 +1,98 @@
+package observation
+
+import (
+	"github.com/AleckDarcy/ContextBus/configure/reaction"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"fmt"
+	"time"
+)
+
+// reasons of tail-based logging
+const (
+	TailSlow         = "slow"
+	TailError        = "error"
+	TailPrerequisite = "prerequisite" // ReactionPrintLog fired
+)
+
+// DefaultTailLogging is used by ReactionPrintLog if its event is not configured with TailLoggingConfigure
+var DefaultTailLogging = &TailLoggingConfigure{
+	Logging: &cb.LoggingConfigure{Out: cb.LogOutType_Stdout},
+	Profile: true,
+}
+
+// Check returns the reason to emit the event chain ending at {ed}, or "" if the request is good
+func (c *TailLoggingConfigure) Check(ed *cb.EventData) string {
+	if c == nil {
+		return ""
+	}
+
+	if c.Slow > 0 {
+		first := ed
+		for first.PrevEventData != nil {
+			first = first.PrevEventData
+		}
+
+		if ed.Event.When.Time-first.Event.When.Time >= c.Slow*int64(time.Millisecond) {
+			return TailSlow
+		}
+	}
+
+	for prevED := ed; prevED != nil && len(c.Errors) != 0; prevED = prevED.PrevEventData {
+		for _, cond := range c.Errors {
+			if (*reaction.AttributeCondition)(cond).Check(prevED.Event.What) {
+				return TailError
+			}
+		}
+	}
+
+	return ""
+}
+
+// Do emits the event chain ending at {ed} in reversed order for {reason},
+// environmental profiles are looked up by {profile} if configured.
+// It returns the number of log entries.
+func (c *TailLoggingConfigure) Do(ed *cb.EventData, reason string, profile func(esp int64) *cb.EnvironmentalProfile) int {
+	if c == nil || c.Logging == nil {
+		return 0
+	}
+
+	logging := (*LoggingConfigure)(c.Logging)
+	buf := make([]byte, 0, 512)
+
+	buf = c.appendEntry(buf[:0], "tail logging", ed.Event.When.Time, "triggered by "+reason, ed)
+	logging.write(string(buf))
+	cnt := 1
+
+	esp := int64(-1)
+	for prevED := ed; prevED != nil; prevED = prevED.PrevEventData {
+		if c.Profile && profile != nil && prevED.Metadata.Esp != esp {
+			esp = prevED.Metadata.Esp
+
+			if es := profile(esp); es != nil {
+				buf = c.appendEntry(buf[:0], "environmental profile", esp, fmt.Sprintf("%v", es), ed)
+				logging.write(string(buf))
+				cnt++
+			}
+		}
+
+		cnt += logging.Do(prevED)
+	}
+
+	return cnt
+}
+
+// appendEntry appends a warning entry of the tail to {buf}
+func (c *TailLoggingConfigure) appendEntry(buf []byte, caller string, when int64, msg string, ed *cb.EventData) []byte {
+	logging := (*LoggingConfigure)(c.Logging)
+	enc := logging.LogEncoder()
+
+	buf = enc.Begin(buf)
+	buf = enc.AppendTime(buf, "time", time.Unix(0, when), logging.timeFormat())
+	buf = enc.AppendString(buf, "level", "warn")
+	buf = enc.AppendString(buf, "caller", caller)
+	buf = enc.AppendString(buf, "message", msg)
+	buf = enc.AppendIDs(buf, "ID", ed.GetMetadata().GetReqId(), ed.GetMetadata().GetEveId())
+
+	return enc.End(buf)
+}
@@ -0,0 +1,87 @@
+package observation
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"testing"
+	"time"
+)
+
+// newTailEventData returns the event chain of a request of {latency}, with rest.method of {method}
+func newTailEventData(latency time.Duration, method string) *cb.EventData {
+	start := time.Now().UnixNano()
+
+	what := new(cb.EventWhat)
+	what.WithLibrary("rest", nil).GetAttributes().SetString("method", method)
+
+	return &cb.EventData{
+		Event:    &cb.EventRepresentation{When: &cb.EventWhen{Time: start + int64(latency)}, What: new(cb.EventWhat)},
+		Metadata: &cb.EventMetadata{ReqId: 1, EveId: 3, Esp: 2},
+		PrevEventData: &cb.EventData{
+			Event:    &cb.EventRepresentation{When: &cb.EventWhen{Time: start + int64(latency/2)}, What: new(cb.EventWhat)},
+			Metadata: &cb.EventMetadata{ReqId: 1, EveId: 2, Esp: 1},
+			PrevEventData: &cb.EventData{
+				Event:    &cb.EventRepresentation{When: &cb.EventWhen{Time: start}, What: what},
+				Metadata: &cb.EventMetadata{ReqId: 1, EveId: 1, Esp: 1},
+			},
+		},
+	}
+}
+
+func TestTailLoggingConfigure_Check(t *testing.T) {
+	tail := &TailLoggingConfigure{
+		Logging: &cb.LoggingConfigure{Out: cb.LogOutType_LogOutType_},
+		Slow:    100,
+		Errors:  []*cb.AttributeCondition{cb.NewAttributeCondition(cb.Test_Path_Rest_Method, cb.AttributeOperator_AttrEQ, []string{"DELETE"})},
+	}
+
+	tests := []struct {
+		ed     *cb.EventData
+		reason string
+	}{
+		{newTailEventData(50*time.Millisecond, "GET"), ""},
+		{newTailEventData(100*time.Millisecond, "GET"), TailSlow},
+		{newTailEventData(50*time.Millisecond, "DELETE"), TailError},
+		{newTailEventData(200*time.Millisecond, "DELETE"), TailSlow},
+	}
+
+	for i, test := range tests {
+		if reason := tail.Check(test.ed); reason != test.reason {
+			t.Error("fail, test:", i, "expect:", test.reason, "got:", reason)
+		}
+	}
+
+	if reason := (*TailLoggingConfigure)(nil).Check(tests[1].ed); reason != "" {
+		t.Error("fail, nil TailLoggingConfigure got:", reason)
+	}
+}
+
+func TestTailLoggingConfigure_Do(t *testing.T) {
+	tail := &TailLoggingConfigure{
+		Logging: &cb.LoggingConfigure{Out: cb.LogOutType_LogOutType_},
+		Slow:    100,
+	}
+	ed := newTailEventData(200*time.Millisecond, "GET")
+
+	esps := []int64{}
+	profile := func(esp int64) *cb.EnvironmentalProfile {
+		esps = append(esps, esp)
+
+		return &cb.EnvironmentalProfile{Timestamp: esp}
+	}
+
+	// reason and 3 events
+	if cnt := tail.Do(ed, TailSlow, profile); cnt != 4 || len(esps) != 0 {
+		t.Error("fail, cnt:", cnt, "esps:", esps)
+	}
+
+	// reason, 3 events and 2 profiles
+	tail.Profile = true
+	if cnt := tail.Do(ed, TailSlow, profile); cnt != 6 || len(esps) != 2 || esps[0] != 2 || esps[1] != 1 {
+		t.Error("fail, cnt:", cnt, "esps:", esps)
+	}
+
+	if cnt := (&TailLoggingConfigure{}).Do(ed, TailSlow, profile); cnt != 0 {
+		t.Error("fail, cnt:", cnt)
+	}
+}
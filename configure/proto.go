@@ -9,5 +9,7 @@ type Configure struct {
 	Reactions    map[string]*reaction.Configure
 	Observations map[string]*cb.ObservationConfigure
 
-	ReactionIndex map[string][]*reaction.Configure // <event name, reaction.Configure where use this event as a prerequisite>
+	ReactionIndex     map[string][]*reaction.Configure // <event name, reaction.Configure where use this event as a prerequisite>
+	SequenceReactions []*reaction.Configure            // reaction.Configure with PrerequisiteSequence_ nodes, updated on every event
+	WindowIndex       map[string]struct{}              // <event name, > counted in reaction.WindowStore
 }
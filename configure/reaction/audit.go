@@ -0,0 +1,154 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// AuditRecord records a fire of a reaction, or a fire that would have happened in dry-run mode
+type AuditRecord struct {
+	Name      string          `json:"name"` // name of the reaction
+	Type      cb.ReactionType `json:"type"`
+	ReqID     uint64          `json:"req_id"`
+	EveID     uint64          `json:"eve_id"`
+	Snapshot  []int64         `json:"snapshot"`  // values of the PrerequisiteSnapshot when accomplished
+	Timestamp int64           `json:"timestamp"` // in ns
+	DryRun    bool            `json:"dry_run"`   // the reaction is not applied
+}
+
+// NewAuditRecord returns the AuditRecord of the reaction fired by {ed} with {snapshot} at {now} (in ns)
+func (c *Configure) NewAuditRecord(ed *cb.EventData, snapshot *cb.PrerequisiteSnapshot, now int64) *AuditRecord {
+	rec := &AuditRecord{
+		Name:      c.Name,
+		Type:      c.Type,
+		ReqID:     ed.GetMetadata().GetReqId(),
+		EveID:     ed.GetMetadata().GetEveId(),
+		Timestamp: now,
+		DryRun:    c.DryRun,
+	}
+	if snapshot != nil {
+		rec.Snapshot = append([]int64(nil), snapshot.Value...)
+	}
+
+	return rec
+}
+
+// auditTrail keeps the latest AuditRecords in a ring buffer, and writes them as JSON lines
+type auditTrail struct {
+	lock    sync.RWMutex
+	records []*AuditRecord
+	next    int // index of the next record in records
+	full    bool
+
+	outLock sync.Mutex // serializes writes, not held with lock
+	out     io.Writer
+}
+
+// AuditTrail keeps the latest AuditRecords of all reactions in-process
+var AuditTrail = newAuditTrail(1024)
+
+// newAuditTrail returns an audit trail keeping the latest {capacity} records, written to os.Stdout
+func newAuditTrail(capacity int) *auditTrail {
+	return &auditTrail{
+		records: make([]*AuditRecord, capacity),
+		out:     os.Stdout,
+	}
+}
+
+// SetWriter sets where records are written to, nil omits writing.
+// Records are written synchronously by the request recording them.
+func (t *auditTrail) SetWriter(out io.Writer) {
+	t.outLock.Lock()
+	t.out = out
+	t.outLock.Unlock()
+}
+
+// Record keeps {rec} and writes it
+func (t *auditTrail) Record(rec *AuditRecord) {
+	t.lock.Lock()
+	if len(t.records) != 0 {
+		t.records[t.next] = rec
+		if t.next++; t.next == len(t.records) {
+			t.next, t.full = 0, true
+		}
+	}
+	t.lock.Unlock()
+
+	t.outLock.Lock()
+	defer t.outLock.Unlock()
+
+	if t.out != nil {
+		if buf, err := json.Marshal(rec); err != nil {
+			fmt.Println("marshal audit record fail:", err)
+		} else {
+			t.out.Write(append(buf, '\n'))
+		}
+	}
+}
+
+// Query returns records matched by {match} in the order of recording, all records if {match} is nil
+func (t *auditTrail) Query(match func(rec *AuditRecord) bool) []*AuditRecord {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	var records []*AuditRecord
+	if t.full {
+		records = append(records, t.records[t.next:]...)
+	}
+	records = append(records, t.records[:t.next]...)
+
+	matched := records[:0]
+	for _, rec := range records {
+		if match == nil || match(rec) {
+			matched = append(matched, rec)
+		}
+	}
+
+	return matched
+}
+
+// Reset drops all records
+func (t *auditTrail) Reset() {
+	t.lock.Lock()
+	for i := range t.records {
+		t.records[i] = nil
+	}
+	t.next, t.full = 0, false
+	t.lock.Unlock()
+}
+
+// AuditByName matches records of reaction {name}
+func AuditByName(name string) func(rec *AuditRecord) bool {
+	return func(rec *AuditRecord) bool {
+		return rec.Name == name
+	}
+}
+
+// AuditByRequest matches records of request {reqID}
+func AuditByRequest(reqID uint64) func(rec *AuditRecord) bool {
+	return func(rec *AuditRecord) bool {
+		return rec.ReqID == reqID
+	}
+}
+
+// AuditSince matches records since {d} ago
+func AuditSince(d time.Duration) func(rec *AuditRecord) bool {
+	since := time.Now().Add(-d).UnixNano()
+
+	return func(rec *AuditRecord) bool {
+		return rec.Timestamp >= since
+	}
+}
+
+// Audit records the fire of the reaction, it returns true if the reaction should be applied
+func (c *Configure) Audit(ed *cb.EventData, snapshot *cb.PrerequisiteSnapshot, now int64) bool {
+	AuditTrail.Record(c.NewAuditRecord(ed, snapshot, now))
+
+	return !c.DryRun
+}
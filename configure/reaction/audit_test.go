@@ -0,0 +1,100 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestAuditTrail(t *testing.T) {
+	buf := &bytes.Buffer{}
+	trail := newAuditTrail(3)
+	trail.SetWriter(buf)
+
+	rac := &Configure{Name: "EventB", Type: cb.ReactionType_ReactionFaultDrop, DryRun: true}
+	snapshot := &cb.PrerequisiteSnapshot{Value: []int64{-1, 2}}
+	for i := uint64(1); i <= 4; i++ {
+		ed := &cb.EventData{Metadata: &cb.EventMetadata{ReqId: i, EveId: i * 10}}
+		trail.Record(rac.NewAuditRecord(ed, snapshot, int64(i)))
+	}
+	snapshot.Value[1] = 3 // records keep a copy
+
+	// the first record is dropped
+	if records := trail.Query(nil); len(records) != 3 {
+		t.Fatal("fail, records:", records)
+	} else {
+		for i, rec := range records {
+			if exp := uint64(i + 2); rec.ReqID != exp || rec.EveID != exp*10 || rec.Timestamp != int64(exp) ||
+				!rec.DryRun || rec.Name != "EventB" || rec.Snapshot[1] != 2 {
+				t.Error("fail, record:", i, rec)
+			}
+		}
+	}
+
+	if records := trail.Query(AuditByRequest(3)); len(records) != 1 || records[0].EveID != 30 {
+		t.Error("fail, records:", records)
+	} else if records = trail.Query(AuditByName("EventC")); len(records) != 0 {
+		t.Error("fail, records:", records)
+	}
+
+	// written as JSON lines
+	dec := json.NewDecoder(buf)
+	for i := uint64(1); i <= 4; i++ {
+		rec := &AuditRecord{}
+		if err := dec.Decode(rec); err != nil {
+			t.Fatal("fail, err:", err)
+		} else if rec.ReqID != i || rec.Type != cb.ReactionType_ReactionFaultDrop {
+			t.Error("fail, record:", rec)
+		}
+	}
+
+	trail.Reset()
+	if records := trail.Query(nil); len(records) != 0 {
+		t.Error("fail, records:", records)
+	}
+}
+
+func TestConfigure_Audit(t *testing.T) {
+	AuditTrail.SetWriter(nil)
+	defer AuditTrail.SetWriter(os.Stdout)
+
+	ed := &cb.EventData{Metadata: &cb.EventMetadata{ReqId: 801}}
+	now := time.Now().UnixNano()
+	if !(&Configure{Name: "EventA"}).Audit(ed, nil, now) {
+		t.Error("fail, reaction should be applied")
+	} else if (&Configure{Name: "EventB", DryRun: true}).Audit(ed, nil, now) {
+		t.Error("fail, reaction should not be applied in dry run")
+	}
+
+	if records := AuditTrail.Query(AuditByRequest(801)); len(records) != 2 || records[0].DryRun || !records[1].DryRun {
+		t.Error("fail, records:", records)
+	} else if records = AuditTrail.Query(AuditSince(time.Minute)); len(records) < 2 {
+		t.Error("fail, records:", records)
+	}
+}
+
+func TestConfigure_Fire_DryRun(t *testing.T) {
+	policy := &cb.FiringPolicy{TotalFires: 1}
+
+	// dry runs do not consume the budget
+	rac := &Configure{Name: "EventA", DryRun: true, Budget: NewBudget(policy)}
+	for i := 0; i < 3; i++ {
+		if !rac.Fire(time.Now().UnixNano()) {
+			t.Error("fail, dry run skipped by budget:", i)
+		}
+	}
+	if fires := rac.Budget.Fires(); fires != 0 {
+		t.Error("fail, fires:", fires)
+	}
+
+	rac.DryRun = false
+	if !rac.Fire(time.Now().UnixNano()) {
+		t.Error("fail, not fired")
+	} else if rac.Fire(time.Now().UnixNano()) {
+		t.Error("fail, fired beyond budget")
+	}
+}
@@ -0,0 +1,100 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Budget tracks remaining fires of a reaction under its FiringPolicy.
+// Budgets are process-level, and reset when the Configure is set again.
+type Budget struct {
+	policy *cb.FiringPolicy
+
+	lock  sync.Mutex
+	rand  *rand.Rand
+	start int64 // start of the current interval, in ns
+	fires int64 // number of fires in the current interval
+	total int64 // number of fires in total
+}
+
+// NewBudget returns nil if {policy} has no limits, nil Budget always fires
+func NewBudget(policy *cb.FiringPolicy) *Budget {
+	if policy.GetProbability() == 0 && policy.GetMaxFires() == 0 && policy.GetTotalFires() == 0 {
+		return nil
+	}
+
+	return &Budget{
+		policy: policy,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Acquire returns true if the reaction fires at {now} (in ns), consuming one fire of the budget.
+// The probability is rolled first, a reaction skipped by the roll consumes nothing.
+func (b *Budget) Acquire(now int64) bool {
+	if b == nil {
+		return true
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if p := b.policy.Probability; p != 0 && b.rand.Float64() >= p {
+		return false
+	} else if total := b.policy.TotalFires; total != 0 && b.total >= total {
+		return false
+	}
+
+	if max := b.policy.MaxFires; max != 0 {
+		if now-b.start >= b.policy.Interval*int64(time.Millisecond) {
+			b.start, b.fires = now, 0
+		}
+
+		if b.fires >= max {
+			return false
+		}
+		b.fires++
+	}
+	b.total++
+
+	return true
+}
+
+// Remaining returns the number of remaining fires at {now} (in ns) in the current interval and in total,
+// -1 if unlimited.
+func (b *Budget) Remaining(now int64) (interval, total int64) {
+	if b == nil {
+		return -1, -1
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	interval, total = -1, -1
+	if max := b.policy.MaxFires; max != 0 {
+		if interval = max; now-b.start < b.policy.Interval*int64(time.Millisecond) {
+			interval -= b.fires
+		}
+	}
+
+	if t := b.policy.TotalFires; t != 0 {
+		total = t - b.total
+	}
+
+	return interval, total
+}
+
+// Fires returns the number of fires in total
+func (b *Budget) Fires() int64 {
+	if b == nil {
+		return 0
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.total
+}
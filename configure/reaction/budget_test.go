@@ -0,0 +1,75 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestNewBudget(t *testing.T) {
+	if NewBudget(nil) != nil || NewBudget(&cb.FiringPolicy{}) != nil {
+		t.Error("fail, unlimited policy")
+	}
+
+	var b *Budget
+	if !b.Acquire(0) || b.Fires() != 0 {
+		t.Error("fail, nil Budget")
+	} else if interval, total := b.Remaining(0); interval != -1 || total != -1 {
+		t.Error("fail, remaining:", interval, total)
+	}
+}
+
+func TestBudget_Acquire(t *testing.T) {
+	ms := int64(time.Millisecond)
+	b := NewBudget(&cb.FiringPolicy{MaxFires: 2, Interval: 100, TotalFires: 5})
+
+	tests := []struct {
+		now      int64 // in ms
+		exp      bool
+		interval int64 // remaining
+		total    int64
+	}{
+		{1000, true, 1, 4},
+		{1050, true, 0, 3},
+		{1099, false, 0, 3},
+		{1100, true, 1, 2}, // next interval
+		{1150, true, 0, 1},
+		{1300, true, 1, 0},
+		{1400, false, 2, 0}, // total fires exhausted
+	}
+
+	for i, test := range tests {
+		if acc := b.Acquire(test.now * ms); acc != test.exp {
+			t.Error("fail, test:", i, "acc:", acc)
+		}
+
+		if interval, total := b.Remaining(test.now * ms); interval != test.interval || total != test.total {
+			t.Error("fail, test:", i, "remaining:", interval, total)
+		}
+	}
+
+	if b.Fires() != 5 {
+		t.Error("fail, fires:", b.Fires())
+	}
+}
+
+func TestBudget_Acquire_Probability(t *testing.T) {
+	b := NewBudget(&cb.FiringPolicy{Probability: 0.3, TotalFires: 5000})
+	b.rand = rand.New(rand.NewSource(1))
+
+	fires := 0
+	for i := 0; i < 10000; i++ {
+		if b.Acquire(int64(i)) {
+			fires++
+		}
+	}
+
+	// skipped rolls consume nothing
+	if fires < 2700 || fires > 3300 || int64(fires) != b.Fires() {
+		t.Error("fail, fires:", fires, b.Fires())
+	} else if _, total := b.Remaining(0); total != 5000-int64(fires) {
+		t.Error("fail, total:", total)
+	}
+}
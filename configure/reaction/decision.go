@@ -0,0 +1,66 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+)
+
+// Decision is returned to the caller of OnSubmission when a reaction fires.
+// A nil Decision means no reaction is fired, getters are nil-safe.
+type Decision struct {
+	Name   string          // name of the fired reaction
+	Type   cb.ReactionType // type of the fired reaction
+	Params interface{}     // isReactionConfigure_Params
+	Err    error           // error to inject, nil if the caller needs no error handling
+}
+
+// NewDecision returns the Decision of a fired reaction
+func (c *Configure) NewDecision(err error) *Decision {
+	return &Decision{
+		Name:   c.Name,
+		Type:   c.Type,
+		Params: c.Params,
+		Err:    err,
+	}
+}
+
+func (d *Decision) GetName() string {
+	if d == nil {
+		return ""
+	}
+
+	return d.Name
+}
+
+func (d *Decision) GetType() cb.ReactionType {
+	if d == nil {
+		return cb.ReactionType_ReactionType_
+	}
+
+	return d.Type
+}
+
+func (d *Decision) GetParams() interface{} {
+	if d == nil {
+		return nil
+	}
+
+	return d.Params
+}
+
+func (d *Decision) GetErr() error {
+	if d == nil {
+		return nil
+	}
+
+	return d.Err
+}
+
+// IsFired returns true if a reaction is fired
+func (d *Decision) IsFired() bool {
+	return d != nil
+}
+
+// IsDrop returns true if the caller should discard the in-flight request or message
+func (d *Decision) IsDrop() bool {
+	return d.GetType() == cb.ReactionType_ReactionFaultDrop
+}
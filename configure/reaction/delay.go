@@ -0,0 +1,71 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Delayer samples delays of ReactionFaultDelay from the DelayDistribution of FaultDelayParam.
+// Delays of a reaction are reproducible if the seed is set, as long as the Configure is not set again.
+type Delayer struct {
+	param *cb.FaultDelayParam
+
+	lock sync.Mutex
+	rand *rand.Rand
+}
+
+// NewDelayer returns nil if {param} is nil, nil Delayer never delays
+func NewDelayer(param *cb.FaultDelayParam) *Delayer {
+	if param == nil {
+		return nil
+	}
+
+	seed := param.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	return &Delayer{
+		param: param,
+		rand:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Next returns the next delay, negative samples are truncated to 0
+func (d *Delayer) Next() time.Duration {
+	if d == nil {
+		return 0
+	}
+
+	ms := float64(time.Millisecond)
+	p := d.param
+	mean := float64(p.Ms) * ms
+
+	var delay float64
+	d.lock.Lock()
+	switch p.Distribution {
+	case cb.DelayDistribution_DelayUniform:
+		delay = float64(p.Min)*ms + d.rand.Float64()*float64(p.Max-p.Min)*ms
+	case cb.DelayDistribution_DelayNormal:
+		delay = mean + d.rand.NormFloat64()*float64(p.Stddev)*ms
+	case cb.DelayDistribution_DelayExponential:
+		delay = d.rand.ExpFloat64() * mean
+	case cb.DelayDistribution_DelayPareto:
+		delay = mean / math.Pow(1-d.rand.Float64(), 1/p.Shape) // 1 - Float64() is in (0, 1]
+	default:
+		delay = mean
+	}
+	d.lock.Unlock()
+
+	if max := float64(p.Max) * ms; p.Max > 0 && delay > max {
+		delay = max
+	} else if delay < 0 {
+		delay = 0
+	}
+
+	return time.Duration(delay)
+}
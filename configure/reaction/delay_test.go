@@ -0,0 +1,58 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDelayer_Next(t *testing.T) {
+	ms := float64(time.Millisecond)
+	tests := []struct {
+		param    *cb.FaultDelayParam
+		mean     float64 // in ms
+		min, max float64 // in ms
+	}{
+		{&cb.FaultDelayParam{Ms: 100}, 100, 100, 100},
+		{&cb.FaultDelayParam{Distribution: cb.DelayDistribution_DelayUniform, Min: 100, Max: 300}, 200, 100, 300},
+		{&cb.FaultDelayParam{Distribution: cb.DelayDistribution_DelayNormal, Ms: 100, Stddev: 10}, 100, 0, math.Inf(1)},
+		{&cb.FaultDelayParam{Distribution: cb.DelayDistribution_DelayExponential, Ms: 100}, 100, 0, math.Inf(1)},
+		{&cb.FaultDelayParam{Distribution: cb.DelayDistribution_DelayPareto, Ms: 100, Shape: 3}, 150, 100, math.Inf(1)}, // mean: shape * scale / (shape - 1)
+		{&cb.FaultDelayParam{Distribution: cb.DelayDistribution_DelayPareto, Ms: 100, Shape: 1, Max: 500}, -1, 100, 500},
+		{&cb.FaultDelayParam{Distribution: cb.DelayDistribution_DelayNormal, Ms: 0, Stddev: 100}, -1, 0, math.Inf(1)}, // truncated
+	}
+
+	for i, test := range tests {
+		test.param.Seed = 1
+		d := NewDelayer(test.param)
+
+		sum, n := 0.0, 10000
+		for j := 0; j < n; j++ {
+			delay := float64(d.Next()) / ms
+			if delay < test.min || delay > test.max {
+				t.Fatal("fail, test:", i, "delay:", delay)
+			}
+			sum += delay
+		}
+
+		if mean := sum / float64(n); test.mean >= 0 && math.Abs(mean-test.mean) > test.mean*0.05 {
+			t.Error("fail, test:", i, "expect:", test.mean, "got:", mean)
+		}
+	}
+}
+
+func TestDelayer_Next_Seed(t *testing.T) {
+	param := &cb.FaultDelayParam{Distribution: cb.DelayDistribution_DelayExponential, Ms: 100, Seed: 42}
+	d1, d2 := NewDelayer(param), NewDelayer(param)
+	for i := 0; i < 100; i++ {
+		if delay1, delay2 := d1.Next(), d2.Next(); delay1 != delay2 {
+			t.Fatal("fail, i:", i, "delays:", delay1, delay2)
+		}
+	}
+
+	if NewDelayer(nil).Next() != 0 {
+		t.Error("fail, nil Delayer")
+	}
+}
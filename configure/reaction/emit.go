@@ -0,0 +1,69 @@
+package reaction
+
+import (
+	cb_context "github.com/AleckDarcy/ContextBus/context"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"errors"
+)
+
+// SyntheticSubmitter submits the synthetic event of ReactionEmitEvent as OnSubmission does,
+// it returns the Decision of the synthetic event, nil if no reaction is fired or the submission loops.
+type SyntheticSubmitter func(ctx *cb_context.Context, where *cb.EventWhere, who *cb.EventRecorder, app *cb.EventMessage) *Decision
+
+var syntheticSubmitter SyntheticSubmitter
+
+// SetSyntheticSubmitter sets the SyntheticSubmitter of ReactionEmitEvent, it is set by the ContextBus package
+func SetSyntheticSubmitter(submitter SyntheticSubmitter) {
+	syntheticSubmitter = submitter
+}
+
+func init() {
+	Register(cb.ReactionType_ReactionEmitEvent, &ReactorFuncs{Sync: reactEmitEvent})
+}
+
+// reactEmitEvent submits the synthetic event triggered by {ed},
+// it returns the Decision of the synthetic event if any reaction of it fires.
+func reactEmitEvent(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) *Decision {
+	if syntheticSubmitter == nil {
+		return rac.NewDecision(errors.New("synthetic submitter not set"))
+	}
+
+	// ed is not pushed to the bus yet, its where is copied before the stacktrace is symbolized
+	who, app := rac.SyntheticEvent(ed)
+	where := (&cb.EventWhere{}).Merge(ed.GetEvent().GetWhere())
+	if synthetic := syntheticSubmitter(ctx, where, who, app); synthetic.IsFired() {
+		return synthetic
+	}
+
+	return rac.NewDecision(nil)
+}
+
+// SyntheticEvent returns the recorder and the application message of the event submitted by ReactionEmitEvent,
+// with attributes copied from the triggering {ed}.
+func (c *Configure) SyntheticEvent(ed *cb.EventData) (*cb.EventRecorder, *cb.EventMessage) {
+	var param *cb.EmitEventParam
+	if emit, ok := c.Params.(*cb.ReactionConfigure_EmitEvent); ok {
+		param = emit.EmitEvent
+	}
+
+	who := &cb.EventRecorder{Type: ed.GetEvent().GetRecorder().GetType(), Name: param.GetName()}
+	app := new(cb.EventMessage).SetMessage(param.GetMessage())
+
+	what := ed.GetEvent().GetWhat()
+	if len(param.GetPaths()) == 0 {
+		app.SetAttributes(what.GetApplication().GetAttrs().Clone())
+
+		return who, app
+	}
+
+	for _, path := range param.Paths {
+		if what == nil || len(path.Path) == 0 {
+			continue
+		} else if value, err := what.GetValue(path); err == nil {
+			app.GetAttributes().SetString(path.Path[len(path.Path)-1], value)
+		}
+	}
+
+	return who, app
+}
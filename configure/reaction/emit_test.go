@@ -0,0 +1,82 @@
+package reaction
+
+import (
+	cb_context "github.com/AleckDarcy/ContextBus/context"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"testing"
+)
+
+func TestConfigure_SyntheticEvent(t *testing.T) {
+	ed := &cb.EventData{Event: &cb.EventRepresentation{
+		Recorder: &cb.EventRecorder{Type: cb.EventRecorderType_EventRecorderServiceHandler, Name: "EventA"},
+		What:     newEventWhat("u1", "POST"),
+	}}
+
+	// all application attributes
+	rac := &Configure{
+		Type:   cb.ReactionType_ReactionEmitEvent,
+		Params: &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{Name: "EventA_derived", Message: "derived"}},
+	}
+	who, app := rac.SyntheticEvent(ed)
+	if who.Name != "EventA_derived" || who.Type != cb.EventRecorderType_EventRecorderServiceHandler || app.Message != "derived" {
+		t.Error("fail, who:", who, "app:", app)
+	} else if val, err := app.GetValue([]string{"user_id"}); err != nil || val != "u1" {
+		t.Error("fail, val:", val, "err:", err)
+	}
+
+	// copied, not shared
+	app.GetAttributes().SetString("user_id", "u2")
+	if val, _ := ed.Event.What.Application.GetValue([]string{"user_id"}); val != "u1" {
+		t.Error("fail, trigger modified:", val)
+	}
+
+	// selected paths
+	rac.Params = &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{Name: "EventA_derived", Paths: []*cb.Path{
+		cb.NewPath(cb.PathType_Library, []string{"rest", "method"}),
+		cb.NewPath(cb.PathType_Application, []string{"not_found"}),
+	}}}
+	_, app = rac.SyntheticEvent(ed)
+	if val, err := app.GetValue([]string{"method"}); err != nil || val != "POST" {
+		t.Error("fail, val:", val, "err:", err)
+	} else if _, err = app.GetValue([]string{"user_id"}); err == nil {
+		t.Error("fail, unexpected user_id")
+	} else if len(app.Attrs.Attrs) != 1 {
+		t.Error("fail, attrs:", app.Attrs)
+	}
+}
+
+func TestReactEmitEvent(t *testing.T) {
+	rac := &Configure{
+		Name:   "EventA",
+		Type:   cb.ReactionType_ReactionEmitEvent,
+		Params: &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{Name: "EventA_derived"}},
+	}
+	if rac.GetReactor() == nil {
+		t.Fatal("fail, ReactionEmitEvent not registered")
+	}
+
+	defer SetSyntheticSubmitter(syntheticSubmitter)
+	where := &cb.EventWhere{Stacktrace: "trigger"}
+	ed := &cb.EventData{Event: &cb.EventRepresentation{Where: where, What: newEventWhat("u1", "")}}
+
+	// no reaction of the synthetic event fires
+	SetSyntheticSubmitter(func(ctx *cb_context.Context, w *cb.EventWhere, who *cb.EventRecorder, app *cb.EventMessage) *Decision {
+		if w == where || who.Name != "EventA_derived" {
+			t.Error("fail, where:", w, "who:", who)
+		}
+
+		return nil
+	})
+	if decision := rac.React(nil, ed); decision.GetName() != "EventA" || decision.GetErr() != nil {
+		t.Error("fail, decision:", decision)
+	}
+
+	// the Decision of the synthetic event is returned
+	SetSyntheticSubmitter(func(ctx *cb_context.Context, w *cb.EventWhere, who *cb.EventRecorder, app *cb.EventMessage) *Decision {
+		return &Decision{Name: who.Name, Type: cb.ReactionType_ReactionFaultDrop}
+	})
+	if decision := rac.React(nil, ed); !decision.IsDrop() || decision.GetName() != "EventA_derived" {
+		t.Error("fail, decision:", decision)
+	}
+}
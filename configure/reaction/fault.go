@@ -0,0 +1,31 @@
+package reaction
+
+// FaultCrash is the panic value raised by ReactionFaultCrash.
+// It aborts the current handler and is expected to be recovered by the caller, see ContextBus.RecoverFaultCrash.
+type FaultCrash struct {
+	Name string // name of the fired reaction
+}
+
+func (f *FaultCrash) Error() string {
+	return "fault crash injected by reaction " + f.Name
+}
+
+// NewFaultCrash returns the panic value for ReactionFaultCrash
+func (c *Configure) NewFaultCrash() *FaultCrash {
+	return &FaultCrash{Name: c.Name}
+}
+
+// FaultDrop is the error injected by ReactionFaultDrop.
+// The caller is expected to discard the in-flight request or message.
+type FaultDrop struct {
+	Name string // name of the fired reaction
+}
+
+func (f *FaultDrop) Error() string {
+	return "fault drop injected by reaction " + f.Name
+}
+
+// NewFaultDrop returns the error for ReactionFaultDrop
+func (c *Configure) NewFaultDrop() *FaultDrop {
+	return &FaultDrop{Name: c.Name}
+}
@@ -0,0 +1,505 @@
+package reaction
+
+import (
+	cb_context "github.com/AleckDarcy/ContextBus/context"
+	"github.com/AleckDarcy/ContextBus/helper"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// default templates of EmailParam
+const (
+	DefaultSubject = `[ContextBus] reaction {{.Name}} fired`
+	DefaultBody    = `reaction {{.Name}} fired on request {{.Event.Metadata.ReqId}}
+{{range .Events}}{{time .Event.When.Time}} {{.Event.Recorder.Name}}: {{message .}}
+{{end}}`
+)
+
+// Notification is sent by Sender, it may carry several coalesced notifications
+type Notification struct {
+	Name    string `json:"name"` // name of the reaction
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	Count   int    `json:"count"` // number of coalesced notifications
+
+	subjects []string // subjects of coalesced notifications, recorded for dedupe once sent
+}
+
+// NotificationData is the data of templates of EmailParam
+type NotificationData struct {
+	Name   string          // name of the reaction
+	Event  *cb.EventData   // the triggering EventData
+	Events []*cb.EventData // Event and its PrevEventData chain, in reversed order
+}
+
+// Sender is the backend of Notifier
+type Sender interface {
+	Send(n *Notification) error
+}
+
+// SMTPSender sends notifications as plain-text emails,
+// the dial and the session are bounded by a timeout as it runs on the observation bus.
+type SMTPSender struct {
+	param   *cb.SMTPParam
+	host    string
+	auth    smtp.Auth
+	timeout time.Duration
+}
+
+func NewSMTPSender(param *cb.SMTPParam) (*SMTPSender, error) {
+	host, _, err := net.SplitHostPort(param.Addr)
+	if err != nil {
+		return nil, err
+	} else if param.From == "" || len(param.To) == 0 {
+		return nil, errors.New("smtp from and to required")
+	}
+
+	s := &SMTPSender{param: param, host: host, timeout: 5 * time.Second}
+	if param.Username != "" {
+		s.auth = smtp.PlainAuth("", param.Username, param.Password, host)
+	}
+
+	return s, nil
+}
+
+// headerReplacer folds line breaks of rendered header values, to prevent header injection
+var headerReplacer = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+func (s *SMTPSender) Send(n *Notification) error {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "From: %s\r\n", s.param.From)
+	fmt.Fprintf(buf, "To: %s\r\n", strings.Join(s.param.To, ", "))
+	fmt.Fprintf(buf, "Subject: %s\r\n", headerReplacer.Replace(n.Subject))
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	buf.WriteString(strings.ReplaceAll(n.Body, "\n", "\r\n"))
+
+	return s.sendMail(buf.Bytes())
+}
+
+// sendMail is smtp.SendMail with a dial timeout and a deadline of the session
+func (s *SMTPSender) sendMail(msg []byte) error {
+	conn, err := net.DialTimeout("tcp", s.param.Addr, s.timeout)
+	if err != nil {
+		return err
+	} else if err = conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		conn.Close()
+
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+	if s.auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp server doesn't support AUTH")
+		} else if err = c.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+
+	if err = c.Mail(s.param.From); err != nil {
+		return err
+	}
+	for _, to := range s.param.To {
+		if err = c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	} else if _, err = w.Write(msg); err != nil {
+		return err
+	} else if err = w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// WebhookSender posts notifications as JSON
+type WebhookSender struct {
+	param  *cb.WebhookParam
+	client *http.Client
+}
+
+func NewWebhookSender(param *cb.WebhookParam) (*WebhookSender, error) {
+	if param.Url == "" {
+		return nil, errors.New("webhook url required")
+	}
+
+	return &WebhookSender{
+		param:  param,
+		client: &http.Client{Timeout: 5 * time.Second},
+	}, nil
+}
+
+func (s *WebhookSender) Send(n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.param.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range s.param.Headers {
+		req.Header.Set(key, value)
+	}
+
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	rsp.Body.Close()
+
+	if rsp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook responded %s", rsp.Status)
+	}
+
+	return nil
+}
+
+var notificationFuncs = template.FuncMap{
+	"time": func(ns int64) string {
+		return time.Unix(0, ns).Format(time.RFC3339Nano)
+	},
+	"message": notificationMessage,
+}
+
+// notificationMessage formats the application message of {ed} as logging does
+func notificationMessage(ed *cb.EventData) string {
+	what := ed.GetEvent().GetWhat()
+	paths := what.GetApplication().GetPaths()
+	values := make([]interface{}, len(paths))
+	for i, path := range paths {
+		value, err := what.GetValue(path)
+		if err != nil {
+			values[i] = fmt.Sprintf("!error(%s)", err.Error())
+		} else {
+			values[i] = value
+		}
+	}
+
+	return fmt.Sprintf(what.GetApplication().GetMessage(), values...)
+}
+
+// Notifier renders and sends notifications of ReactionEmail,
+// notifications are deduped by subject and coalesced as configured in EmailParam.
+type Notifier struct {
+	param   *cb.EmailParam
+	subject *template.Template
+	body    *template.Template
+	sender  Sender
+
+	lock    sync.Mutex
+	sent    map[string]int64 // <subject, time in ns>, for dedupe
+	pending *Notification    // coalescing
+
+	queue  chan *notifyTask // notifications sent by the worker, see NotifyAsync
+	worker sync.WaitGroup
+	closed bool
+}
+
+// notifyTask is a notification waiting in Notifier.queue
+type notifyTask struct {
+	name string
+	ed   *cb.EventData
+	now  int64
+}
+
+// NewNotifier returns nil if {param} is nil, or an error if templates or the Sender are invalid
+func NewNotifier(param *cb.EmailParam) (*Notifier, error) {
+	if param == nil {
+		return nil, nil
+	}
+
+	var sender Sender
+	var err error
+	if param.Smtp != nil && param.Webhook != nil {
+		return nil, errors.New("either smtp or webhook expected")
+	} else if param.Smtp != nil {
+		sender, err = NewSMTPSender(param.Smtp)
+	} else if param.Webhook != nil {
+		sender, err = NewWebhookSender(param.Webhook)
+	} else {
+		return nil, errors.New("smtp or webhook required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNotifierWithSender(param, sender)
+}
+
+// NewNotifierWithSender returns a Notifier sending via {sender} instead of the backend of {param}
+func NewNotifierWithSender(param *cb.EmailParam, sender Sender) (*Notifier, error) {
+	if param.DedupeWindow < 0 || param.CoalesceWindow < 0 {
+		return nil, errors.New("negative window")
+	}
+
+	subject, body := param.Subject, param.Body
+	if subject == "" {
+		subject = DefaultSubject
+	}
+	if body == "" {
+		body = DefaultBody
+	}
+
+	n := &Notifier{
+		param:  param,
+		sender: sender,
+		sent:   map[string]int64{},
+	}
+
+	var err error
+	if n.subject, err = template.New("subject").Funcs(notificationFuncs).Parse(subject); err != nil {
+		return nil, err
+	} else if n.body, err = template.New("body").Funcs(notificationFuncs).Parse(body); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func (n *Notifier) render(name string, ed *cb.EventData) (*Notification, error) {
+	data := &NotificationData{Name: name, Event: ed}
+	for prev := ed; prev != nil; prev = prev.PrevEventData {
+		data.Events = append(data.Events, prev)
+	}
+
+	subject, body := &strings.Builder{}, &strings.Builder{}
+	if err := n.subject.Execute(subject, data); err != nil {
+		return nil, err
+	} else if err = n.body.Execute(body, data); err != nil {
+		return nil, err
+	}
+
+	return &Notification{
+		Name:    name,
+		Subject: strings.TrimSpace(subject.String()),
+		Body:    body.String(),
+		Count:   1,
+	}, nil
+}
+
+// Notify renders the notification of reaction {name} triggered by {ed} at {now} (in ns) and sends it,
+// it returns false if the notification is deduped or coalesced.
+// The subject is recorded for dedupe once sent, a failed notification is not deduped.
+func (n *Notifier) Notify(name string, ed *cb.EventData, now int64) (bool, error) {
+	if n == nil {
+		return false, nil
+	}
+
+	notification, err := n.render(name, ed)
+	if err != nil {
+		return false, err
+	}
+
+	n.lock.Lock()
+	if window := n.param.DedupeWindow * int64(time.Millisecond); window > 0 {
+		if last, ok := n.sent[notification.Subject]; ok && now-last < window {
+			n.lock.Unlock()
+
+			return false, nil
+		}
+
+		for subject, last := range n.sent {
+			if now-last >= window {
+				delete(n.sent, subject)
+			}
+		}
+	}
+
+	if window := n.param.CoalesceWindow; window > 0 {
+		if n.pending == nil {
+			notification.subjects = []string{notification.Subject}
+			n.pending = notification
+			time.AfterFunc(time.Duration(window)*time.Millisecond, func() {
+				if err := n.Flush(); err != nil {
+					fmt.Println("send coalesced notification fail:", err)
+				}
+			})
+		} else {
+			n.pending.Body += "\n" + notification.Body
+			n.pending.Count++
+			n.pending.subjects = append(n.pending.subjects, notification.Subject)
+		}
+		n.lock.Unlock()
+
+		return false, nil
+	}
+	n.lock.Unlock()
+
+	if err = n.sender.Send(notification); err != nil {
+		return false, err
+	}
+	n.record(now, notification.Subject)
+
+	return true, nil
+}
+
+// record records {subjects} sent at {now} (in ns) for dedupe
+func (n *Notifier) record(now int64, subjects ...string) {
+	if n.param.DedupeWindow <= 0 {
+		return
+	}
+
+	n.lock.Lock()
+	for _, subject := range subjects {
+		n.sent[subject] = now
+	}
+	n.lock.Unlock()
+}
+
+// Flush sends coalescing notifications immediately
+func (n *Notifier) Flush() error {
+	if n == nil {
+		return nil
+	}
+
+	n.lock.Lock()
+	pending := n.pending
+	n.pending = nil
+	n.lock.Unlock()
+
+	if pending == nil {
+		return nil
+	} else if pending.Count > 1 {
+		pending.Subject = fmt.Sprintf("%s (+%d more)", pending.Subject, pending.Count-1)
+	}
+
+	if err := n.sender.Send(pending); err != nil {
+		return err
+	}
+	n.record(time.Now().UnixNano(), pending.subjects...)
+
+	return nil
+}
+
+// NotifyAsync queues the notification of Notify for the worker of the Notifier, to keep the caller off the Sender.
+// It returns an error if the queue of helper.NOTIFIER_QUEUE_SIZE is full or the Notifier is closed.
+func (n *Notifier) NotifyAsync(name string, ed *cb.EventData, now int64) error {
+	if n == nil {
+		return nil
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.closed {
+		return errors.New("notifier closed")
+	} else if n.queue == nil {
+		n.queue = make(chan *notifyTask, helper.NOTIFIER_QUEUE_SIZE)
+		n.worker.Add(1)
+		go n.work()
+		notifiers.add(n)
+	}
+
+	select {
+	case n.queue <- &notifyTask{name: name, ed: ed, now: now}:
+		return nil
+	default:
+		return errors.New("notification queue full")
+	}
+}
+
+func (n *Notifier) work() {
+	defer n.worker.Done()
+
+	for task := range n.queue {
+		if ok, err := n.Notify(task.name, task.ed, task.now); err != nil {
+			fmt.Println("notify fail:", err)
+		} else if ok {
+			fmt.Println("notified by", task.name)
+		}
+	}
+}
+
+// Close sends queued and coalescing notifications, later notifications are rejected by NotifyAsync
+func (n *Notifier) Close() error {
+	if n == nil {
+		return nil
+	}
+
+	n.lock.Lock()
+	queue := n.queue
+	if !n.closed && queue != nil {
+		close(queue)
+	}
+	n.closed = true
+	n.lock.Unlock()
+
+	n.worker.Wait()
+
+	return n.Flush()
+}
+
+// notifiers keeps Notifiers with running workers, see CloseNotifiers
+var notifiers = &notifierStore{m: map[*Notifier]struct{}{}}
+
+type notifierStore struct {
+	lock sync.Mutex
+	m    map[*Notifier]struct{}
+}
+
+func (s *notifierStore) add(n *Notifier) {
+	s.lock.Lock()
+	s.m[n] = struct{}{}
+	s.lock.Unlock()
+}
+
+// CloseNotifiers closes all Notifiers used by NotifyAsync, it is expected to be called before the service exits
+func CloseNotifiers() error {
+	notifiers.lock.Lock()
+	m := notifiers.m
+	notifiers.m = map[*Notifier]struct{}{}
+	notifiers.lock.Unlock()
+
+	var err error
+	for n := range m {
+		if cErr := n.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+	}
+
+	return err
+}
+
+func init() {
+	Register(cb.ReactionType_ReactionEmail, &ReactorFuncs{Async: reactEmail})
+}
+
+// reactEmail queues the notification on the observation bus, it is sent by the worker of the Notifier
+// to keep the bus off the SMTP server or webhook
+func reactEmail(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) {
+	if err := rac.Notifier.NotifyAsync(rac.Name, ed, time.Now().UnixNano()); err != nil {
+		fmt.Println("notify fail:", err)
+	}
+}
@@ -0,0 +1,383 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"github.com/golang/protobuf/proto"
+
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpFakeServer accepts mails and sends their data to mails
+type smtpFakeServer struct {
+	listener net.Listener
+	mails    chan string
+	auths    chan string
+}
+
+func newSMTPFakeServer(t *testing.T) *smtpFakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	s := &smtpFakeServer{listener: listener, mails: make(chan string, 16), auths: make(chan string, 16)}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go s.serve(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *smtpFakeServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(msg string) { conn.Write([]byte(msg + "\r\n")) }
+
+	reply("220 localhost fake SMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		switch cmd := strings.ToUpper(strings.Fields(line + " ")[0]); cmd {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			s.auths <- strings.TrimSpace(line)
+			reply("235 authenticated")
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			reply("250 OK")
+		case "DATA":
+			reply("354 go ahead")
+
+			data := &strings.Builder{}
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				} else if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			s.mails <- data.String()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 unsupported")
+		}
+	}
+}
+
+// webhookFakeServer receives notifications like prometheusFakeGateway
+func webhookFakeServer(notifications chan *Notification) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := &Notification{}
+		if err := json.NewDecoder(r.Body).Decode(n); err != nil || r.Header.Get("X-Token") != "token" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		notifications <- n
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func newNotificationEventData() *cb.EventData {
+	what := newEventWhat("u1", "")
+	what.Application.SetMessage("user %s").SetPaths([]*cb.Path{cb.NewPath(cb.PathType_Application, []string{"user_id"})})
+
+	return &cb.EventData{
+		Event: &cb.EventRepresentation{
+			When:     &cb.EventWhen{Time: 2e9},
+			Recorder: &cb.EventRecorder{Name: "EventB"},
+			What:     what,
+		},
+		Metadata: &cb.EventMetadata{ReqId: 1},
+		PrevEventData: &cb.EventData{
+			Event: &cb.EventRepresentation{
+				When:     &cb.EventWhen{Time: 1e9},
+				Recorder: &cb.EventRecorder{Name: "EventA"},
+				What:     &cb.EventWhat{Application: new(cb.EventMessage).SetMessage("received")},
+			},
+			Metadata: &cb.EventMetadata{ReqId: 1},
+		},
+	}
+}
+
+func TestNotifier_SMTP(t *testing.T) {
+	s := newSMTPFakeServer(t)
+	defer s.listener.Close()
+
+	n, err := NewNotifier(&cb.EmailParam{
+		Smtp: &cb.SMTPParam{Addr: s.listener.Addr().String(), From: "cb@test", To: []string{"a@test", "b@test"}, Username: "cb", Password: "pwd"},
+	})
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	if ok, err := n.Notify("EventB", newNotificationEventData(), time.Now().UnixNano()); !ok || err != nil {
+		t.Fatal("fail, ok:", ok, "err:", err)
+	}
+
+	select {
+	case auth := <-s.auths:
+		if !strings.HasPrefix(auth, "AUTH PLAIN") {
+			t.Error("fail, auth:", auth)
+		}
+	default:
+		t.Error("fail, not authenticated")
+	}
+
+	mail := <-s.mails
+	for _, expect := range []string{
+		"To: a@test, b@test\r\n",
+		"Subject: [ContextBus] reaction EventB fired\r\n",
+		"reaction EventB fired on request 1\r\n",
+		"EventB: user u1\r\n",
+		"EventA: received\r\n",
+	} {
+		if !strings.Contains(mail, expect) {
+			t.Errorf("fail, expect: %q, mail: %q", expect, mail)
+		}
+	}
+	if strings.Index(mail, "EventB: user") > strings.Index(mail, "EventA: received") {
+		t.Error("fail, events not in reversed order:", mail)
+	}
+}
+
+func TestSMTPSender_Subject(t *testing.T) {
+	s := newSMTPFakeServer(t)
+	defer s.listener.Close()
+
+	sender, err := NewSMTPSender(&cb.SMTPParam{Addr: s.listener.Addr().String(), From: "cb@test", To: []string{"a@test"}})
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	if err = sender.Send(&Notification{Subject: "fired\r\nBcc: x@test\nX-Injected: 1", Body: "body"}); err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	mail := <-s.mails
+	if !strings.Contains(mail, "Subject: fired Bcc: x@test X-Injected: 1\r\n") || strings.Contains(mail, "\r\nBcc:") || strings.Contains(mail, "\nX-Injected:") {
+		t.Errorf("fail, mail: %q", mail)
+	}
+}
+
+func TestSMTPSender_Timeout(t *testing.T) {
+	// accepts connections but never replies
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	s, err := NewSMTPSender(&cb.SMTPParam{Addr: listener.Addr().String(), From: "cb@test", To: []string{"a@test"}})
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+	s.timeout = 100 * time.Millisecond
+
+	start := time.Now()
+	if err = s.Send(&Notification{Subject: "subject", Body: "body"}); err == nil {
+		t.Error("fail, timeout expected")
+	} else if elapsed := time.Since(start); elapsed > time.Second {
+		t.Error("fail, elapsed:", elapsed)
+	}
+}
+
+func TestNotifier_Webhook(t *testing.T) {
+	notifications := make(chan *Notification, 16)
+	s := webhookFakeServer(notifications)
+	defer s.Close()
+
+	n, err := NewNotifier(&cb.EmailParam{
+		Webhook:      &cb.WebhookParam{Url: s.URL, Headers: map[string]string{"X-Token": "token"}},
+		Subject:      `{{.Name}} {{.Event.Event.Recorder.Name}}`,
+		Body:         `{{message .Event}}`,
+		DedupeWindow: 1000,
+	})
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	ed, now := newNotificationEventData(), time.Now().UnixNano()
+	if ok, err := n.Notify("EventB", ed, now); !ok || err != nil {
+		t.Fatal("fail, ok:", ok, "err:", err)
+	} else if got := <-notifications; got.Subject != "EventB EventB" || got.Body != "user u1" || got.Count != 1 {
+		t.Error("fail, notification:", got)
+	}
+
+	// deduped within 1s
+	if ok, err := n.Notify("EventB", ed, now+int64(500*time.Millisecond)); ok || err != nil {
+		t.Error("fail, ok:", ok, "err:", err)
+	}
+	if ok, err := n.Notify("EventB", ed, now+int64(time.Second)); !ok || err != nil {
+		t.Error("fail, ok:", ok, "err:", err)
+	} else if got := <-notifications; got.Subject != "EventB EventB" {
+		t.Error("fail, notification:", got)
+	}
+
+	// rejected by webhook
+	n, _ = NewNotifier(&cb.EmailParam{Webhook: &cb.WebhookParam{Url: s.URL}})
+	if _, err := n.Notify("EventB", ed, now); err == nil {
+		t.Error("fail, error expected")
+	}
+}
+
+func TestNotifier_Coalesce(t *testing.T) {
+	notifications := make(chan *Notification, 16)
+	s := webhookFakeServer(notifications)
+	defer s.Close()
+
+	n, err := NewNotifier(&cb.EmailParam{
+		Webhook:        &cb.WebhookParam{Url: s.URL, Headers: map[string]string{"X-Token": "token"}},
+		Subject:        `{{.Name}}`,
+		Body:           `{{.Event.Metadata.ReqId}}`,
+		CoalesceWindow: 100,
+	})
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	ed := newNotificationEventData()
+	for i := 1; i <= 3; i++ {
+		ed.Metadata.ReqId = uint64(i)
+		if ok, err := n.Notify("EventB", ed, time.Now().UnixNano()); ok || err != nil {
+			t.Error("fail, ok:", ok, "err:", err)
+		}
+	}
+
+	select {
+	case got := <-notifications:
+		if got.Subject != "EventB (+2 more)" || got.Body != "1\n2\n3" || got.Count != 3 {
+			t.Error("fail, notification:", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("fail, coalesced notification not sent")
+	}
+
+	select {
+	case got := <-notifications:
+		t.Error("fail, unexpected notification:", got)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+// failSender fails the first {fails} sends
+type failSender struct {
+	fails int
+	sent  chan *Notification
+}
+
+func (s *failSender) Send(n *Notification) error {
+	if s.fails > 0 {
+		s.fails--
+
+		return errors.New("send fail")
+	}
+	s.sent <- n
+
+	return nil
+}
+
+func TestNotifier_DedupeFailed(t *testing.T) {
+	sender := &failSender{fails: 1, sent: make(chan *Notification, 16)}
+	n, err := NewNotifierWithSender(&cb.EmailParam{DedupeWindow: 1000}, sender)
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	// the failed notification is retried within the dedupe window
+	ed, now := newNotificationEventData(), time.Now().UnixNano()
+	if ok, err := n.Notify("EventB", ed, now); ok || err == nil {
+		t.Error("fail, ok:", ok, "err:", err)
+	} else if ok, err = n.Notify("EventB", ed, now+1); !ok || err != nil {
+		t.Error("fail, ok:", ok, "err:", err)
+	} else if ok, err = n.Notify("EventB", ed, now+2); ok || err != nil {
+		t.Error("fail, deduped expected, ok:", ok, "err:", err)
+	}
+
+	if len(sender.sent) != 1 {
+		t.Error("fail, sent:", len(sender.sent))
+	}
+}
+
+func TestNotifier_NotifyAsync(t *testing.T) {
+	sender := &failSender{sent: make(chan *Notification, 16)}
+	n, err := NewNotifierWithSender(&cb.EmailParam{Subject: `{{.Name}}`, Body: `{{.Event.Metadata.ReqId}}`, CoalesceWindow: 60000}, sender)
+	if err != nil {
+		t.Fatal("fail, err:", err)
+	}
+
+	ed := newNotificationEventData()
+	for i := 1; i <= 2; i++ {
+		ed := proto.Clone(ed).(*cb.EventData)
+		ed.Metadata.ReqId = uint64(i)
+		if err := n.NotifyAsync("EventB", ed, time.Now().UnixNano()); err != nil {
+			t.Error("fail, err:", err)
+		}
+	}
+
+	// coalescing notifications are sent on close
+	if err = n.Close(); err != nil {
+		t.Error("fail, err:", err)
+	} else if got := <-sender.sent; got.Subject != "EventB (+1 more)" || got.Body != "1\n2" {
+		t.Error("fail, notification:", got)
+	}
+
+	if err = n.NotifyAsync("EventB", ed, time.Now().UnixNano()); err == nil {
+		t.Error("fail, closed notifier accepts notifications")
+	}
+}
+
+func TestNewNotifier_Error(t *testing.T) {
+	params := []*cb.EmailParam{
+		{},
+		{Smtp: &cb.SMTPParam{Addr: "localhost:25", From: "cb@test", To: []string{"a@test"}}, Webhook: &cb.WebhookParam{Url: "http://localhost"}},
+		{Smtp: &cb.SMTPParam{Addr: "localhost", From: "cb@test", To: []string{"a@test"}}},
+		{Smtp: &cb.SMTPParam{Addr: "localhost:25", From: "cb@test"}},
+		{Webhook: &cb.WebhookParam{}},
+		{Webhook: &cb.WebhookParam{Url: "http://localhost"}, Subject: "{{.Name"},
+		{Webhook: &cb.WebhookParam{Url: "http://localhost"}, Body: "{{unknown .}}"},
+		{Webhook: &cb.WebhookParam{Url: "http://localhost"}, DedupeWindow: -1},
+	}
+
+	for i, param := range params {
+		if _, err := NewNotifier(param); err == nil {
+			t.Error("fail, error expected, param:", i)
+		}
+	}
+}
@@ -13,8 +13,46 @@ func NewPrerequisiteTree(tree *cb.PrerequisiteTree) *PrerequisiteTree {
 	for _, node := range t.Nodes {
 		if node.Type == cb.PrerequisiteNodeType_PrerequisiteMessage_ {
 			t.Index[node.Message.Name] = (*PrerequisiteNode)(node)
+
+			for _, cond := range node.Message.GetCondTree().GetNodes() {
+				if cond.GetMessage().GetType() == cb.ConditionType_NumOfInvokWindow {
+					t.Windows = append(t.Windows, node.Message.Name)
+
+					break
+				}
+			}
+		} else if node.Type == cb.PrerequisiteNodeType_PrerequisiteAfterObservation_ {
+			t.AfterObservation = true
+			t.PrevEvents = append(t.PrevEvents, node.PrevEvent)
+		} else if node.Type == cb.PrerequisiteNodeType_PrerequisiteSequence_ {
+			t.Sequences = append(t.Sequences, (*PrerequisiteNode)(node))
+			t.failures = append(t.failures, sequenceFailures(node.Sequence.GetNames()))
 		}
 	}
 
+	t.program = compileProgram(tree)
+
 	return t
 }
+
+// sequenceFailures returns the KMP failure links of {names}:
+// failures[i] is the length of the longest proper prefix of names[:i+1] which is also its suffix.
+func sequenceFailures(names []string) []int64 {
+	failures := make([]int64, len(names))
+	for i, k := 1, int64(0); i < len(names); i++ {
+		for k > 0 && names[i] != names[k] {
+			k = failures[k-1]
+		}
+		if names[i] == names[k] {
+			k++
+		}
+		failures[i] = k
+	}
+
+	return failures
+}
+
+// IsSequence returns true if the tree needs to be updated on every event
+func (t *PrerequisiteTree) IsSequence() bool {
+	return len(t.Sequences) != 0
+}
@@ -4,12 +4,35 @@ import (
 	cb "github.com/AleckDarcy/ContextBus/proto"
 
 	"errors"
+	"time"
 )
 
 // Check if prerequisites are accomplished.
-// Using greedy strategy: does not detect lineage among prerequisite nodes.
+// Using greedy strategy: does not detect lineage among prerequisite nodes,
+// except for the order of names inside a PrerequisiteSequence_ node.
+
+// ConditionValue is the value compared by ConditionMessage
+type ConditionValue struct {
+	Type  cb.ConditionType
+	Value int64
+	Name  string // event name, for NumOfInvokWindow
+}
+
+// Check compares value {v} of the given ConditionType,
+// ConditionMessage of unspecified type (ConditionType_) matches any type.
+// NumOfInvokWindow compares the number of invocations of {v.Name} in WindowStore.
+func (c *ConditionMessage) Check(v ConditionValue) (bool, error) {
+	val := v.Value
+	if c.Type == cb.ConditionType_NumOfInvokWindow {
+		if v.Type != cb.ConditionType_NumOfInvok {
+			return false, errors.New("unexpected ConditionType")
+		}
+
+		val = WindowStore.Count(v.Name, c.Window*int64(time.Millisecond), time.Now().UnixNano())
+	} else if c.Type != cb.ConditionType_ConditionType_ && c.Type != v.Type {
+		return false, errors.New("unexpected ConditionType")
+	}
 
-func (c *ConditionMessage) Check(val int64) (bool, error) {
 	switch c.Op {
 	case cb.ConditionOperator_LT:
 		return val < c.Value, nil
@@ -28,11 +51,11 @@ func (c *ConditionMessage) Check(val int64) (bool, error) {
 	}
 }
 
-func (c *ConditionLogic) Check(nodes []*cb.ConditionNode, val int64) (bool, error) {
+func (c *ConditionLogic) Check(nodes []*cb.ConditionNode, v ConditionValue) (bool, error) {
 	switch c.Type {
 	case cb.LogicType_And_:
 		for _, nodeID := range c.List {
-			if acc, err := (*ConditionNode)(nodes[nodeID]).Check(nodes, val); err != nil {
+			if acc, err := (*ConditionNode)(nodes[nodeID]).Check(nodes, v); err != nil {
 				return false, err
 			} else if !acc {
 				return false, nil
@@ -42,7 +65,7 @@ func (c *ConditionLogic) Check(nodes []*cb.ConditionNode, val int64) (bool, erro
 		return true, nil
 	case cb.LogicType_Or_:
 		for _, nodeID := range c.List {
-			if acc, err := (*ConditionNode)(nodes[nodeID]).Check(nodes, val); err != nil {
+			if acc, err := (*ConditionNode)(nodes[nodeID]).Check(nodes, v); err != nil {
 				return false, err
 			} else if acc {
 				return true, nil
@@ -50,40 +73,187 @@ func (c *ConditionLogic) Check(nodes []*cb.ConditionNode, val int64) (bool, erro
 		}
 
 		return false, nil
+	case cb.LogicType_Not_:
+		if len(c.List) != 1 {
+			return false, errors.New("invalid list length for Not_")
+		}
+
+		acc, err := (*ConditionNode)(nodes[c.List[0]]).Check(nodes, v)
+		if err != nil {
+			return false, err
+		}
+
+		return !acc, nil
+	case cb.LogicType_Xor_:
+		cnt := 0
+		for _, nodeID := range c.List {
+			if acc, err := (*ConditionNode)(nodes[nodeID]).Check(nodes, v); err != nil {
+				return false, err
+			} else if acc {
+				if cnt++; cnt > 1 {
+					return false, nil
+				}
+			}
+		}
+
+		return cnt == 1, nil
+	case cb.LogicType_KOf_:
+		cnt := int64(0)
+		for i, nodeID := range c.List {
+			if cnt >= c.K {
+				return true, nil
+			} else if cnt+int64(len(c.List)-i) < c.K {
+				return false, nil
+			}
+
+			if acc, err := (*ConditionNode)(nodes[nodeID]).Check(nodes, v); err != nil {
+				return false, err
+			} else if acc {
+				cnt++
+			}
+		}
+
+		return cnt >= c.K, nil
 	default:
 		return false, errors.New("unsupported PrerequisiteLogicType")
 	}
 }
 
-func (n *ConditionNode) Check(nodes []*cb.ConditionNode, val int64) (bool, error) {
+func (n *ConditionNode) Check(nodes []*cb.ConditionNode, v ConditionValue) (bool, error) {
 	if n.Type == cb.ConditionNodeType_ConditionMessage_ {
-		return (*ConditionMessage)(n.Message).Check(val)
+		return (*ConditionMessage)(n.Message).Check(v)
 	} else if n.Type == cb.ConditionNodeType_ConditionLogic_ {
-		return (*ConditionLogic)(n.Logic).Check(nodes, val)
+		return (*ConditionLogic)(n.Logic).Check(nodes, v)
 	}
 
 	return false, errors.New("unsupported ConditionNodeType")
 }
 
+// Check compares the value addressed by Path in {what}.
+// Note that Check returns false if the value is not found, for all operators.
+func (c *AttributeCondition) Check(what *cb.EventWhat) bool {
+	if what == nil || c.Path == nil {
+		return false
+	}
+
+	val, err := what.GetValue(c.Path)
+	if err != nil {
+		return false
+	}
+
+	switch c.Op {
+	case cb.AttributeOperator_AttrEQ:
+		return len(c.Values) == 1 && val == c.Values[0]
+	case cb.AttributeOperator_AttrNE:
+		return len(c.Values) == 1 && val != c.Values[0]
+	case cb.AttributeOperator_AttrIn, cb.AttributeOperator_AttrNotIn:
+		in := false
+		for _, value := range c.Values {
+			if val == value {
+				in = true
+
+				break
+			}
+		}
+
+		return in == (c.Op == cb.AttributeOperator_AttrIn)
+	default:
+		return false
+	}
+}
+
+// CheckAttributes returns true if all AttributeConditions hold for the invocation carrying {what}
+func (m *PrerequisiteMessage) CheckAttributes(what *cb.EventWhat) bool {
+	for _, attr := range m.Attrs {
+		if !(*AttributeCondition)(attr).Check(what) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Check
 // Note that Check returns true if Conds is empty
 func (m *PrerequisiteMessage) Check(snapshot *PrerequisiteSnapshot, id int64) (bool, error) {
 	val := snapshot.Value[id]
 
 	if m.CondTree != nil && len(m.CondTree.Nodes) != 0 {
-		return (*ConditionNode)(m.CondTree.Nodes[0]).Check(m.CondTree.Nodes, val)
+		v := ConditionValue{Type: cb.ConditionType_NumOfInvok, Value: val, Name: m.Name}
+
+		return (*ConditionNode)(m.CondTree.Nodes[0]).Check(m.CondTree.Nodes, v)
 	}
 
 	return true, nil
 }
 
+// Check compares the latency (in ms) between the previous event {Name} and the current event {ed}.
+// Note that Check returns false if the previous event is not found,
+// and checks latency > {Latency} if CondTree is empty.
+func (e *PrerequisiteEvent) Check(ed *cb.EventData) (bool, error) {
+	if ed == nil {
+		return false, errors.New("PrerequisiteEvent requires EventData after observation")
+	}
+
+	prevED := ed.GetPreviousEventData(e.Name)
+	if prevED == nil {
+		return false, nil
+	}
+
+	latency := (ed.Event.When.Time - prevED.Event.When.Time) / int64(time.Millisecond)
+
+	if e.CondTree != nil && len(e.CondTree.Nodes) != 0 {
+		v := ConditionValue{Type: cb.ConditionType_Latency, Value: latency}
+
+		return (*ConditionNode)(e.CondTree.Nodes[0]).Check(e.CondTree.Nodes, v)
+	}
+
+	return latency > e.Latency, nil
+}
+
+// Check returns true if all names are observed in order
+func (s *PrerequisiteSequence) Check(snapshot *PrerequisiteSnapshot, id int64) (bool, error) {
+	return snapshot.Value[id] >= int64(len(s.Names)), nil
+}
+
+// Check compares the value of Path in the latest EnvironmentalProfile {ep}.
+// Note that Check returns false if the value is missing, e.g., EnvironmentProfiler is not running.
+func (p *PrerequisiteProfile) Check(ep *cb.EnvironmentalProfile) (bool, error) {
+	field, ok := ProfileFields[p.Path]
+	if !ok {
+		return false, errors.New("unsupported profile path")
+	}
+
+	val, ok := field(ep)
+	if !ok {
+		return false, nil
+	}
+
+	switch p.Op {
+	case cb.ConditionOperator_LT:
+		return val < p.Value, nil
+	case cb.ConditionOperator_GT:
+		return val > p.Value, nil
+	case cb.ConditionOperator_LE:
+		return val <= p.Value, nil
+	case cb.ConditionOperator_GE:
+		return val >= p.Value, nil
+	case cb.ConditionOperator_EQ:
+		return val == p.Value, nil
+	case cb.ConditionOperator_NE:
+		return val != p.Value, nil
+	default:
+		return false, errors.New("unsupported operation type")
+	}
+}
+
 // Check
-// Note that Check returns true if List is empty
-func (l *PrerequisiteLogic) Check(tree *PrerequisiteTree, snapshot *PrerequisiteSnapshot, id int64) (bool, error) {
+// Note that Check returns true if List is empty for And_, and false for Or_ and Xor_
+func (l *PrerequisiteLogic) Check(tree *PrerequisiteTree, snapshot *PrerequisiteSnapshot, ed *cb.EventData, id int64) (bool, error) {
 	switch l.Type {
 	case cb.LogicType_And_:
 		for _, nodeID := range l.List {
-			if acc, err := (*PrerequisiteNode)(tree.Nodes[nodeID]).Check(tree, snapshot, nodeID); err != nil {
+			if acc, err := (*PrerequisiteNode)(tree.Nodes[nodeID]).Check(tree, snapshot, ed, nodeID); err != nil {
 				return false, err
 			} else if !acc {
 				return false, nil
@@ -93,7 +263,7 @@ func (l *PrerequisiteLogic) Check(tree *PrerequisiteTree, snapshot *Prerequisite
 		return true, nil
 	case cb.LogicType_Or_:
 		for _, nodeID := range l.List {
-			if acc, err := (*PrerequisiteNode)(tree.Nodes[nodeID]).Check(tree, snapshot, nodeID); err != nil {
+			if acc, err := (*PrerequisiteNode)(tree.Nodes[nodeID]).Check(tree, snapshot, ed, nodeID); err != nil {
 				return false, err
 			} else if acc {
 				return true, nil
@@ -101,22 +271,80 @@ func (l *PrerequisiteLogic) Check(tree *PrerequisiteTree, snapshot *Prerequisite
 		}
 
 		return false, nil
+	case cb.LogicType_Not_:
+		if len(l.List) != 1 {
+			return false, errors.New("invalid list length for Not_")
+		}
+
+		acc, err := (*PrerequisiteNode)(tree.Nodes[l.List[0]]).Check(tree, snapshot, ed, l.List[0])
+		if err != nil {
+			return false, err
+		}
+
+		return !acc, nil
+	case cb.LogicType_Xor_:
+		cnt := 0
+		for _, nodeID := range l.List {
+			if acc, err := (*PrerequisiteNode)(tree.Nodes[nodeID]).Check(tree, snapshot, ed, nodeID); err != nil {
+				return false, err
+			} else if acc {
+				if cnt++; cnt > 1 {
+					return false, nil
+				}
+			}
+		}
+
+		return cnt == 1, nil
+	case cb.LogicType_KOf_:
+		cnt := int64(0)
+		for i, nodeID := range l.List {
+			if cnt >= l.K {
+				return true, nil
+			} else if cnt+int64(len(l.List)-i) < l.K {
+				return false, nil
+			}
+
+			if acc, err := (*PrerequisiteNode)(tree.Nodes[nodeID]).Check(tree, snapshot, ed, nodeID); err != nil {
+				return false, err
+			} else if acc {
+				cnt++
+			}
+		}
+
+		return cnt >= l.K, nil
 	default:
 		return false, errors.New("unsupported PrerequisiteLogicType")
 	}
 }
 
-func (n *PrerequisiteNode) Check(tree *PrerequisiteTree, snapshot *PrerequisiteSnapshot, id int64) (bool, error) {
+func (n *PrerequisiteNode) Check(tree *PrerequisiteTree, snapshot *PrerequisiteSnapshot, ed *cb.EventData, id int64) (bool, error) {
 	if n.Type == cb.PrerequisiteNodeType_PrerequisiteMessage_ {
 		return (*PrerequisiteMessage)(n.Message).Check(snapshot, id)
 	} else if n.Type == cb.PrerequisiteNodeType_PrerequisiteLogic_ {
-		return (*PrerequisiteLogic)(n.Logic).Check(tree, snapshot, id)
+		return (*PrerequisiteLogic)(n.Logic).Check(tree, snapshot, ed, id)
+	} else if n.Type == cb.PrerequisiteNodeType_PrerequisiteAfterObservation_ {
+		return (*PrerequisiteEvent)(n.PrevEvent).Check(ed)
+	} else if n.Type == cb.PrerequisiteNodeType_PrerequisiteSequence_ {
+		return (*PrerequisiteSequence)(n.Sequence).Check(snapshot, id)
+	} else if n.Type == cb.PrerequisiteNodeType_PrerequisiteProfile_ {
+		return (*PrerequisiteProfile)(n.Profile).Check(ProfileStore.Get())
 	}
 
 	return false, errors.New("unsupported PrerequisiteNodeType")
 }
 
+// Check prerequisites during OnSubmission.
+// Trees with PrerequisiteAfterObservation_ nodes are checked on the bus, see CheckAfterObservation.
 func (t *PrerequisiteTree) Check(snapshot *PrerequisiteSnapshot) (bool, error) {
+	return t.check(snapshot, nil)
+}
+
+// CheckAfterObservation checks prerequisites on the bus with the observed EventData
+func (t *PrerequisiteTree) CheckAfterObservation(snapshot *PrerequisiteSnapshot, ed *cb.EventData) (bool, error) {
+	return t.check(snapshot, ed)
+}
+
+func (t *PrerequisiteTree) check(snapshot *PrerequisiteSnapshot, ed *cb.EventData) (bool, error) {
 	if len(t.Nodes) != len(snapshot.Value) {
 		return false, errors.New("prerequisite length not match")
 	}
@@ -125,8 +353,17 @@ func (t *PrerequisiteTree) Check(snapshot *PrerequisiteSnapshot) (bool, error) {
 		return true, nil
 	}
 
+	if t.program != nil {
+		return t.program.run(snapshot, ed)
+	}
+
+	return t.checkRecursive(snapshot, ed)
+}
+
+// checkRecursive walks the tree top-down, for trees can not be compiled
+func (t *PrerequisiteTree) checkRecursive(snapshot *PrerequisiteSnapshot, ed *cb.EventData) (bool, error) {
 	// top-down
-	if ok, err := (*PrerequisiteNode)(t.Nodes[0]).Check(t, snapshot, 0); err != nil {
+	if ok, err := (*PrerequisiteNode)(t.Nodes[0]).Check(t, snapshot, ed, 0); err != nil {
 		return false, err
 	} else if !ok {
 		return false, nil
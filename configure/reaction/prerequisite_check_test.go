@@ -1,7 +1,10 @@
 package reaction
 
 import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
 	"testing"
+	"time"
 )
 
 func TestPrerequisiteTree_Check_1(t *testing.T) {
@@ -24,6 +27,131 @@ func TestPrerequisiteTree_Check_1(t *testing.T) {
 	}
 }
 
+func TestPrerequisiteTree_CheckAfterObservation_2(t *testing.T) {
+	newED := func(name string, ms int64, prev *cb.EventData) *cb.EventData {
+		return &cb.EventData{
+			Event: &cb.EventRepresentation{
+				When:     &cb.EventWhen{Time: ms * int64(time.Millisecond)},
+				Recorder: &cb.EventRecorder{Name: name},
+			},
+			PrevEventData: prev,
+		}
+	}
+
+	edB := newED("EventB", 0, nil)
+	edC := newED("EventC", 50, edB)
+
+	snapshot := Tree2.InitializeSnapshot()
+	Tree2.UpdateSnapshot("EventA", snapshot)
+	if _, err := Tree2.Check(snapshot); err == nil {
+		t.Error("fail, PrerequisiteAfterObservation_ requires EventData")
+	}
+
+	tests := []struct {
+		eventA int
+		ed     *cb.EventData
+		exp    bool
+	}{
+		{0, newED("EventD", 150, edC), false},                     // EventA = 0
+		{1, newED("EventD", 150, edC), true},                      // EventB -> EventD 150ms
+		{1, newED("EventD", 99, edC), false},                      // EventB -> EventD 99ms, EventC -> EventD 49ms
+		{1, newED("EventD", 99, nil), false},                      // no previous event
+		{1, newED("EventD", 251, newED("EventC", 50, nil)), true}, // EventC -> EventD 201ms
+		{2, newED("EventD", 150, edC), false},                     // EventA = 2
+	}
+
+	for i, test := range tests {
+		snapshot := Tree2.InitializeSnapshot()
+		for j := 0; j < test.eventA; j++ {
+			Tree2.UpdateSnapshot("EventA", snapshot)
+		}
+
+		acc, err := Tree2.CheckAfterObservation(snapshot, test.ed)
+		if err != nil || acc != test.exp {
+			t.Error("fail, test:", i, "err:", err, "acc:", acc)
+		}
+	}
+}
+
+func TestConditionMessage_Check_Type(t *testing.T) {
+	cond := (*ConditionMessage)(cb.Test_Condition_2_2_0.Message)
+	if _, err := cond.Check(ConditionValue{Type: cb.ConditionType_NumOfInvok, Value: 100}); err == nil {
+		t.Error("fail, Latency condition checks NumOfInvok value")
+	}
+
+	if acc, err := cond.Check(ConditionValue{Type: cb.ConditionType_Latency, Value: 100}); err != nil || !acc {
+		t.Error("fail, err:", err, "acc:", acc)
+	}
+}
+
+func TestPrerequisiteTree_Check_4(t *testing.T) {
+	for _, name := range Tree4.Windows {
+		WindowStore.Register(name)
+	}
+
+	// invocations are counted across snapshots (requests)
+	expected := []bool{false, false, true, true}
+	for _, exp := range expected {
+		WindowStore.Add("EventW", time.Now().UnixNano())
+
+		snapshot := Tree4.InitializeSnapshot()
+		Tree4.UpdateSnapshot("EventW", snapshot)
+		acc, err := Tree4.Check(snapshot)
+		if err != nil || acc != exp {
+			t.Error("fail, err:", err, "acc:", acc)
+		}
+	}
+}
+
+func TestPrerequisiteTree_Check_Logic(t *testing.T) {
+	tests := []struct {
+		tree   *PrerequisiteTree
+		events []string
+		exp    bool
+	}{
+		{Tree5, []string{"EventA"}, false},
+		{Tree5, []string{"EventA", "EventB"}, false},
+		{Tree5, []string{"EventA", "EventB", "EventB"}, true},
+		{Tree5, []string{"EventA", "EventB", "EventB", "EventB"}, false},
+		{Tree5, []string{"EventB", "EventB"}, false},
+		{Tree6, []string{"EventA"}, false},
+		{Tree6, []string{"EventA", "EventB"}, true},
+		{Tree6, []string{"EventA", "EventC"}, true},
+		{Tree6, []string{"EventB", "EventC", "EventC", "EventC"}, false},
+		{Tree6, []string{"EventA", "EventB", "EventC"}, true},
+		{Tree7, []string{}, false},
+		{Tree7, []string{"EventA"}, true},
+		{Tree7, []string{"EventB"}, true},
+		{Tree7, []string{"EventA", "EventB"}, false},
+	}
+
+	for i, test := range tests {
+		snapshot := test.tree.InitializeSnapshot()
+		for _, event := range test.events {
+			test.tree.UpdateSnapshot(event, snapshot)
+		}
+
+		acc, err := test.tree.Check(snapshot)
+		if err != nil || acc != test.exp {
+			t.Error("fail, test:", i, "err:", err, "acc:", acc)
+		}
+	}
+}
+
+func TestPrerequisiteLogic_Check_Not(t *testing.T) {
+	tree := NewPrerequisiteTree(&cb.PrerequisiteTree{
+		Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_Not_, -1, []int64{1, 2}),
+			cb.NewPrerequisiteMessageNode(1, "EventA", nil, 0, nil),
+			cb.NewPrerequisiteMessageNode(2, "EventB", nil, 0, nil),
+		},
+	})
+
+	if _, err := tree.Check(tree.InitializeSnapshot()); err == nil {
+		t.Error("fail, Not_ requires exactly one node")
+	}
+}
+
 func BenchmarkPrerequisiteTree_Check_0(b *testing.B) {
 	snapshot := Tree0.InitializeSnapshot()
 	Tree0.UpdateSnapshot("EventA", snapshot)
@@ -33,3 +161,112 @@ func BenchmarkPrerequisiteTree_Check_0(b *testing.B) {
 		Tree0.Check(snapshot)
 	}
 }
+
+// newEventWhat returns EventWhat with application attribute user_id and library attribute rest.method
+func newEventWhat(userID, method string) *cb.EventWhat {
+	what := &cb.EventWhat{Libraries: &cb.LibrariesMessage{Libraries: map[string]*cb.EventMessage{}}}
+	if userID != "" {
+		what.Application = &cb.EventMessage{Attrs: &cb.Attributes{Attrs: map[string]*cb.AttributeValue{
+			"user_id": {Type: cb.AttributeValueType_AttributeValueStr, Str: userID},
+		}}}
+	}
+	if method != "" {
+		what.Libraries.Libraries["rest"] = &cb.EventMessage{Attrs: &cb.Attributes{Attrs: map[string]*cb.AttributeValue{
+			"method": {Type: cb.AttributeValueType_AttributeValueStr, Str: method},
+		}}}
+	}
+
+	return what
+}
+
+func TestAttributeCondition_Check(t *testing.T) {
+	method := cb.NewPath(cb.PathType_Library, []string{"rest", "method"})
+	userID := cb.NewPath(cb.PathType_Application, []string{"user_id"})
+
+	tests := []struct {
+		attr *cb.AttributeCondition
+		what *cb.EventWhat
+		exp  bool
+	}{
+		{cb.NewAttributeCondition(method, cb.AttributeOperator_AttrEQ, []string{"POST"}), newEventWhat("", "POST"), true},
+		{cb.NewAttributeCondition(method, cb.AttributeOperator_AttrEQ, []string{"POST"}), newEventWhat("", "GET"), false},
+		{cb.NewAttributeCondition(method, cb.AttributeOperator_AttrNE, []string{"POST"}), newEventWhat("", "GET"), true},
+		{cb.NewAttributeCondition(userID, cb.AttributeOperator_AttrIn, []string{"u1", "u2"}), newEventWhat("u2", ""), true},
+		{cb.NewAttributeCondition(userID, cb.AttributeOperator_AttrIn, []string{"u1", "u2"}), newEventWhat("u3", ""), false},
+		{cb.NewAttributeCondition(userID, cb.AttributeOperator_AttrNotIn, []string{"u1", "u2"}), newEventWhat("u3", ""), true},
+		{cb.NewAttributeCondition(userID, cb.AttributeOperator_AttrNotIn, []string{"u1", "u2"}), newEventWhat("u1", ""), false},
+		// values not found
+		{cb.NewAttributeCondition(method, cb.AttributeOperator_AttrNE, []string{"POST"}), newEventWhat("u1", ""), false},
+		{cb.NewAttributeCondition(userID, cb.AttributeOperator_AttrNotIn, []string{"u1"}), newEventWhat("", "GET"), false},
+		{cb.NewAttributeCondition(userID, cb.AttributeOperator_AttrNotIn, []string{"u1"}), nil, false},
+		{cb.NewAttributeCondition(userID, cb.AttributeOperator_AttrNotIn, []string{"u1"}), &cb.EventWhat{Application: &cb.EventMessage{}}, false},
+	}
+
+	for i, test := range tests {
+		if (*AttributeCondition)(test.attr).Check(test.what) != test.exp {
+			t.Error("fail, test:", i)
+		}
+	}
+}
+
+func TestPrerequisiteProfile_Check(t *testing.T) {
+	ep := &cb.EnvironmentalProfile{
+		Hardware: &cb.HardwareProfile{
+			Cpu: &cb.CPUProfile{Percent: 85.5},
+			Mem: &cb.MemProfile{Used: 3 << 30, UsedPercent: 75},
+		},
+		Language: &cb.LanguageProfile{Type: cb.LanguageType_Golang, Profile: &cb.LanguageProfile_Go{Go: &cb.LanguageGo{HeapInuse: 1 << 30}}},
+	}
+
+	tests := []struct {
+		profile *cb.PrerequisiteProfile
+		ep      *cb.EnvironmentalProfile
+		exp     bool
+	}{
+		{cb.NewPrerequisiteProfile("hardware.cpu.percent", cb.ConditionOperator_GT, 80, -1), ep, true},
+		{cb.NewPrerequisiteProfile("hardware.cpu.percent", cb.ConditionOperator_GT, 90, -1), ep, false},
+		{cb.NewPrerequisiteProfile("hardware.mem.usedPercent", cb.ConditionOperator_LE, 75, -1), ep, true},
+		{cb.NewPrerequisiteProfile("hardware.mem.used", cb.ConditionOperator_GE, 2<<30, -1), ep, true},
+		{cb.NewPrerequisiteProfile("language.go.HeapInuse", cb.ConditionOperator_EQ, 1<<30, -1), ep, true},
+		// values missing
+		{cb.NewPrerequisiteProfile("hardware.net.dropin", cb.ConditionOperator_EQ, 0, -1), ep, false},
+		{cb.NewPrerequisiteProfile("hardware.cpu.percent", cb.ConditionOperator_LT, 80, -1), nil, false},
+		{cb.NewPrerequisiteProfile("language.go.HeapInuse", cb.ConditionOperator_GE, 0, -1), &cb.EnvironmentalProfile{}, false},
+	}
+
+	for i, test := range tests {
+		if acc, err := (*PrerequisiteProfile)(test.profile).Check(test.ep); err != nil || acc != test.exp {
+			t.Error("fail, test:", i, "err:", err, "acc:", acc)
+		}
+	}
+
+	if _, err := (*PrerequisiteProfile)(cb.NewPrerequisiteProfile("hardware.cpu", cb.ConditionOperator_GT, 80, -1)).Check(ep); err == nil {
+		t.Error("fail, unsupported path")
+	}
+}
+
+func TestPrerequisiteTree_Check_Profile(t *testing.T) {
+	defer ProfileStore.Set(ProfileStore.Get())
+
+	snapshot := Tree9.InitializeSnapshot()
+	Tree9.UpdateSnapshot("EventA", snapshot)
+
+	tests := []struct {
+		ep  *cb.EnvironmentalProfile
+		exp bool
+	}{
+		{nil, false}, // EnvironmentProfiler is not running
+		{&cb.EnvironmentalProfile{Hardware: &cb.HardwareProfile{Cpu: &cb.CPUProfile{Percent: 50}}}, false},
+		{&cb.EnvironmentalProfile{Hardware: &cb.HardwareProfile{Cpu: &cb.CPUProfile{Percent: 90}}}, true},
+	}
+
+	for i, test := range tests {
+		ProfileStore.Set(test.ep)
+
+		if acc, err := Tree9.Check(snapshot); err != nil || acc != test.exp {
+			t.Error("fail, test:", i, "err:", err, "acc:", acc)
+		} else if acc, err = Tree9.checkRecursive(snapshot, nil); err != nil || acc != test.exp {
+			t.Error("fail, test:", i, "err:", err, "acc:", acc)
+		}
+	}
+}
@@ -0,0 +1,1057 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Text DSL of prerequisite trees, e.g., ((EventA) && (EventB = 1)) || (1 < EventC < 4).
+//
+//	expr     := expr || expr | expr ^ expr | expr && expr | !expr | (expr)
+//	          | k of {expr, ...}            // KOf_, at least k expressions are true
+//	          | event                       // leaf node
+//	event    := name                        // PrerequisiteMessage_ without conditions
+//	          | subject term                // e.g., EventB = 1, EventW within 10000ms > 2
+//	          | subject [cond]              // nested condition tree, e.g., EventC[(> 1) && (!= 3)]
+//	          | value < subject < value     // range, <= is also allowed, e.g., 1 < EventC < 4
+//	          | seq(name, ... [; gap=n])    // PrerequisiteSequence_, gap is unlimited if omitted
+//	          | profile(field) op value     // PrerequisiteProfile_, e.g., profile(hardware.cpu.percent) > 80
+//	subject  := name [{attr, ...}]          // PrerequisiteMessage_, counting invocations matching all attrs
+//	          | latency(name)               // PrerequisiteAfterObservation_
+//	attr     := path op "value"             // op is = or !=, e.g., rest.method = "POST"
+//	          | path [not] in {"value", ...}
+//	path     := _.key | library.key         // cb.Path of Application or Libraries, see EventWhat.GetValue
+//	term     := [within value] op value     // within is for NumOfInvokWindow
+//	cond     := same as expr, with term as leaf nodes
+//	op       := < | > | <= | >= | = | == | !=
+//
+// Precedence from high to low: !, &&, ^, ||. Chained operators compile into a single logic node,
+// e.g., A ^ B ^ C is exactly one of {A, B, C}. Values of latency and window are in ms,
+// the "ms" suffix is optional. latency(name) > value compiles into PrerequisiteEvent.Latency.
+// Values of profile(field) may be decimals, or have byte units KiB, MiB, GiB and TiB, see ProfileFields.
+//
+// Nodes are numbered in pre-order, which is the layout of the hand-built trees in proto.
+
+type dslTokenKind int
+
+const (
+	dslEOF dslTokenKind = iota
+	dslName
+	dslNumber
+	dslPunct
+	dslString
+)
+
+type dslToken struct {
+	kind dslTokenKind
+	text string
+	val  int64   // integer value
+	num  float64 // decimal value, e.g., for profile(path)
+	frac bool    // number with fraction, e.g., 80.5
+	unit string  // suffix in dslUnits, e.g., ms, GiB
+	pos  int
+}
+
+func (t dslToken) is(text string) bool {
+	return (t.kind == dslName || t.kind == dslPunct) && t.text == text
+}
+
+var dslOperators = map[string]cb.ConditionOperator{
+	"<":  cb.ConditionOperator_LT,
+	">":  cb.ConditionOperator_GT,
+	"<=": cb.ConditionOperator_LE,
+	">=": cb.ConditionOperator_GE,
+	"=":  cb.ConditionOperator_EQ,
+	"==": cb.ConditionOperator_EQ,
+	"!=": cb.ConditionOperator_NE,
+}
+
+var dslOperatorTexts = map[cb.ConditionOperator]string{
+	cb.ConditionOperator_LT: "<",
+	cb.ConditionOperator_GT: ">",
+	cb.ConditionOperator_LE: "<=",
+	cb.ConditionOperator_GE: ">=",
+	cb.ConditionOperator_EQ: "=",
+	cb.ConditionOperator_NE: "!=",
+}
+
+// binary logic operators, from low to high precedence
+var dslBinaryOperators = []struct {
+	text string
+	typ  cb.LogicType
+}{
+	{"||", cb.LogicType_Or_},
+	{"^", cb.LogicType_Xor_},
+	{"&&", cb.LogicType_And_},
+}
+
+var dslLogicTexts = map[cb.LogicType]string{
+	cb.LogicType_Or_:  "||",
+	cb.LogicType_Xor_: "^",
+	cb.LogicType_And_: "&&",
+}
+
+// dslUnits are suffixes of numbers, ms for latency and window, and byte units for profile(path)
+var dslUnits = map[string]float64{
+	"ms":  1,
+	"KiB": 1 << 10,
+	"MiB": 1 << 20,
+	"GiB": 1 << 30,
+	"TiB": 1 << 40,
+}
+
+func dslIsLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func dslIsDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func dslIsNameChar(c byte) bool {
+	return dslIsLetter(c) || dslIsDigit(c) || c == '.' || c == '-' || c == '/'
+}
+
+func dslLex(expr string) ([]dslToken, error) {
+	var tokens []dslToken
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case dslIsLetter(c):
+			j := i + 1
+			for j < len(expr) && dslIsNameChar(expr[j]) {
+				j++
+			}
+			tokens = append(tokens, dslToken{kind: dslName, text: expr[i:j], pos: i})
+			i = j
+		case c == '"':
+			j := i + 1
+			for ; j < len(expr) && expr[j] != '"'; j++ {
+				if expr[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			val, err := strconv.Unquote(expr[i : j+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %s at offset %d", expr[i:j+1], i)
+			}
+			tokens = append(tokens, dslToken{kind: dslString, text: val, pos: i})
+			i = j + 1
+		case dslIsDigit(c) || c == '-' && i+1 < len(expr) && dslIsDigit(expr[i+1]):
+			j := i + 1
+			for j < len(expr) && dslIsDigit(expr[j]) {
+				j++
+			}
+			if j+1 < len(expr) && expr[j] == '.' && dslIsDigit(expr[j+1]) {
+				for j += 2; j < len(expr) && dslIsDigit(expr[j]); j++ {
+				}
+			}
+
+			token := dslToken{kind: dslNumber, text: expr[i:j], pos: i}
+			var err error
+			if token.num, err = strconv.ParseFloat(token.text, 64); err != nil {
+				return nil, fmt.Errorf("invalid number %q at offset %d", token.text, i)
+			} else if token.frac = strings.Contains(token.text, "."); !token.frac {
+				if token.val, err = strconv.ParseInt(token.text, 10, 64); err != nil {
+					return nil, fmt.Errorf("invalid number %q at offset %d", token.text, i)
+				}
+			}
+
+			k := j
+			for k < len(expr) && dslIsLetter(expr[k]) {
+				k++
+			}
+			if _, ok := dslUnits[expr[j:k]]; ok && (k == len(expr) || !dslIsNameChar(expr[k])) {
+				token.unit = expr[j:k]
+				j = k
+			}
+			tokens = append(tokens, token)
+			i = j
+		default:
+			text := ""
+			if i+1 < len(expr) {
+				switch two := expr[i : i+2]; two {
+				case "&&", "||", "<=", ">=", "==", "!=":
+					text = two
+				}
+			}
+			if text == "" {
+				if !strings.ContainsRune("()[]{},;^!<>=", rune(c)) {
+					return nil, fmt.Errorf("unexpected character %q at offset %d", c, i)
+				}
+				text = expr[i : i+1]
+			}
+			tokens = append(tokens, dslToken{kind: dslPunct, text: text, pos: i})
+			i += len(text)
+		}
+	}
+
+	return append(tokens, dslToken{kind: dslEOF, pos: len(expr)}), nil
+}
+
+// dslNode is the syntax tree of both prerequisite and condition expressions
+type dslNode struct {
+	logic    cb.LogicType // LogicType_ for leaf nodes
+	k        int64        // for KOf_
+	children []*dslNode
+
+	leaf func(id, parent int64) *cb.PrerequisiteNode // prerequisite leaf
+	cond *cb.ConditionMessage                        // condition leaf
+}
+
+type dslParser struct {
+	tokens []dslToken
+	pos    int
+}
+
+func (p *dslParser) peek() dslToken {
+	return p.tokens[p.pos]
+}
+
+func (p *dslParser) peekAt(offset int) dslToken {
+	if p.pos+offset >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+
+	return p.tokens[p.pos+offset]
+}
+
+func (p *dslParser) next() dslToken {
+	t := p.tokens[p.pos]
+	if t.kind != dslEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+func (p *dslParser) errorf(t dslToken, format string, args ...interface{}) error {
+	if t.kind == dslEOF {
+		return fmt.Errorf(format+" at end of expression", args...)
+	}
+
+	return fmt.Errorf(format+" at offset %d", append(args, t.pos)...)
+}
+
+func (p *dslParser) expect(text string) error {
+	if t := p.next(); !t.is(text) {
+		return p.errorf(t, "expect %q, got %q", text, t.text)
+	}
+
+	return nil
+}
+
+func (p *dslParser) expectNumber() (dslToken, error) {
+	t := p.next()
+	if t.kind != dslNumber {
+		return t, p.errorf(t, "expect number, got %q", t.text)
+	}
+
+	return t, nil
+}
+
+// intValue returns the integer value of {t}, the unit ms is allowed if {ms}
+func (p *dslParser) intValue(t dslToken, ms bool) (int64, error) {
+	if t.frac {
+		return 0, p.errorf(t, "unexpected decimal %s", t.text)
+	} else if t.unit != "" && (t.unit != "ms" || !ms) {
+		return 0, p.errorf(t, "unexpected unit %s", t.unit)
+	}
+
+	return t.val, nil
+}
+
+func (p *dslParser) expectName() (string, error) {
+	t := p.next()
+	if t.kind != dslName {
+		return "", p.errorf(t, "expect event name, got %q", t.text)
+	}
+
+	return t.text, nil
+}
+
+// parseLogic parses logic expressions, using {leaf} to parse leaf nodes
+func (p *dslParser) parseLogic(level int, leaf func() (*dslNode, error)) (*dslNode, error) {
+	if level == len(dslBinaryOperators) {
+		return p.parseUnary(leaf)
+	}
+
+	op := dslBinaryOperators[level]
+	node, err := p.parseLogic(level+1, leaf)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().is(op.text) {
+		node = &dslNode{logic: op.typ, children: []*dslNode{node}}
+		for p.peek().is(op.text) {
+			p.next()
+
+			child, err := p.parseLogic(level+1, leaf)
+			if err != nil {
+				return nil, err
+			}
+			node.children = append(node.children, child)
+		}
+	}
+
+	return node, nil
+}
+
+func (p *dslParser) parseUnary(leaf func() (*dslNode, error)) (*dslNode, error) {
+	t := p.peek()
+	if t.is("!") {
+		p.next()
+
+		child, err := p.parseUnary(leaf)
+		if err != nil {
+			return nil, err
+		}
+
+		return &dslNode{logic: cb.LogicType_Not_, children: []*dslNode{child}}, nil
+	} else if t.is("(") {
+		p.next()
+
+		node, err := p.parseLogic(0, leaf)
+		if err != nil {
+			return nil, err
+		} else if err = p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return node, nil
+	} else if t.kind == dslNumber && p.peekAt(1).is("of") {
+		return p.parseKOf(leaf)
+	}
+
+	return leaf()
+}
+
+func (p *dslParser) parseKOf(leaf func() (*dslNode, error)) (*dslNode, error) {
+	k := p.next()
+	p.next() // of
+	if _, err := p.intValue(k, false); err != nil {
+		return nil, err
+	} else if err = p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	node := &dslNode{logic: cb.LogicType_KOf_, k: k.val}
+	for {
+		child, err := p.parseLogic(0, leaf)
+		if err != nil {
+			return nil, err
+		}
+		node.children = append(node.children, child)
+
+		if !p.peek().is(",") {
+			break
+		}
+		p.next()
+	}
+
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	} else if k.val < 1 || k.val > int64(len(node.children)) {
+		return nil, p.errorf(k, "k of KOf_ out of range [1, %d]", len(node.children))
+	}
+
+	return node, nil
+}
+
+func (p *dslParser) expectString() (string, error) {
+	t := p.next()
+	if t.kind != dslString {
+		return "", p.errorf(t, "expect string, got %q", t.text)
+	}
+
+	return t.text, nil
+}
+
+// dslPath parses {str} into cb.Path, e.g., _.key for Application, library.key for Libraries
+func dslPath(str string) *cb.Path {
+	path := strings.Split(str, ".")
+	if path[0] == "_" {
+		return cb.NewPath(cb.PathType_Application, path[1:])
+	}
+
+	return cb.NewPath(cb.PathType_Library, path)
+}
+
+// parseSubject returns the event name, the ConditionType and AttributeConditions of the subject
+func (p *dslParser) parseSubject() (string, cb.ConditionType, []*cb.AttributeCondition, error) {
+	if p.peek().is("latency") && p.peekAt(1).is("(") {
+		p.next()
+		p.next()
+
+		name, err := p.expectName()
+		if err != nil {
+			return "", 0, nil, err
+		} else if err = p.expect(")"); err != nil {
+			return "", 0, nil, err
+		}
+
+		return name, cb.ConditionType_Latency, nil, nil
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return "", 0, nil, err
+	}
+
+	var attrs []*cb.AttributeCondition
+	if p.peek().is("{") {
+		if attrs, err = p.parseAttributes(); err != nil {
+			return "", 0, nil, err
+		}
+	}
+
+	return name, cb.ConditionType_NumOfInvok, attrs, nil
+}
+
+// parseAttributes parses {attr, ...}
+func (p *dslParser) parseAttributes() ([]*cb.AttributeCondition, error) {
+	p.next() // {
+
+	var attrs []*cb.AttributeCondition
+	for {
+		t := p.next()
+		if t.kind != dslName {
+			return nil, p.errorf(t, "expect attribute path, got %q", t.text)
+		}
+		attr := &cb.AttributeCondition{Path: dslPath(t.text)}
+
+		switch t = p.next(); {
+		case t.is("=") || t.is("=="), t.is("!="):
+			attr.Op = cb.AttributeOperator_AttrEQ
+			if t.is("!=") {
+				attr.Op = cb.AttributeOperator_AttrNE
+			}
+
+			val, err := p.expectString()
+			if err != nil {
+				return nil, err
+			}
+			attr.Values = []string{val}
+		case t.is("in"), t.is("not"):
+			attr.Op = cb.AttributeOperator_AttrIn
+			if t.is("not") {
+				attr.Op = cb.AttributeOperator_AttrNotIn
+				if err := p.expect("in"); err != nil {
+					return nil, err
+				}
+			}
+
+			values, err := p.parseStrings()
+			if err != nil {
+				return nil, err
+			}
+			attr.Values = values
+		default:
+			return nil, p.errorf(t, "expect =, !=, in or not in, got %q", t.text)
+		}
+		attrs = append(attrs, attr)
+
+		if !p.peek().is(",") {
+			break
+		}
+		p.next()
+	}
+
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+
+	return attrs, nil
+}
+
+// parseStrings parses {"value", ...}
+func (p *dslParser) parseStrings() ([]string, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for {
+		val, err := p.expectString()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, val)
+
+		if !p.peek().is(",") {
+			break
+		}
+		p.next()
+	}
+
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// parseTerm parses a ConditionMessage of the given ConditionType
+func (p *dslParser) parseTerm(typ cb.ConditionType) (*dslNode, error) {
+	cond := &cb.ConditionMessage{Type: typ}
+	if t := p.peek(); t.is("within") {
+		if typ != cb.ConditionType_NumOfInvok {
+			return nil, p.errorf(t, "within is not allowed for latency")
+		}
+		p.next()
+
+		window, err := p.expectNumber()
+		if err != nil {
+			return nil, err
+		} else if cond.Window, err = p.intValue(window, true); err != nil {
+			return nil, err
+		} else if cond.Window <= 0 {
+			return nil, p.errorf(window, "window must be positive")
+		}
+
+		cond.Type = cb.ConditionType_NumOfInvokWindow
+	}
+
+	t := p.next()
+	op, ok := dslOperators[t.text]
+	if !ok || t.kind != dslPunct {
+		return nil, p.errorf(t, "expect operator, got %q", t.text)
+	}
+	cond.Op = op
+
+	val, err := p.expectNumber()
+	if err != nil {
+		return nil, err
+	} else if cond.Value, err = p.intValue(val, typ == cb.ConditionType_Latency); err != nil {
+		return nil, err
+	}
+
+	return &dslNode{cond: cond}, nil
+}
+
+func (p *dslParser) parsePrerequisite() (*dslNode, error) {
+	t := p.peek()
+	if t.kind == dslNumber {
+		return p.parseRange()
+	} else if t.is("seq") && p.peekAt(1).is("(") {
+		return p.parseSequence()
+	} else if t.is("profile") && p.peekAt(1).is("(") {
+		return p.parseProfile()
+	}
+
+	name, typ, attrs, err := p.parseSubject()
+	if err != nil {
+		return nil, err
+	}
+
+	var cond *dslNode
+	if t = p.peek(); t.is("[") {
+		p.next()
+
+		if cond, err = p.parseLogic(0, func() (*dslNode, error) { return p.parseTerm(typ) }); err != nil {
+			return nil, err
+		} else if err = p.expect("]"); err != nil {
+			return nil, err
+		}
+	} else if _, ok := dslOperators[t.text]; (ok && t.kind == dslPunct) || t.is("within") {
+		if cond, err = p.parseTerm(typ); err != nil {
+			return nil, err
+		}
+	} else if typ == cb.ConditionType_Latency {
+		return nil, p.errorf(t, "latency requires conditions")
+	}
+
+	return dslLeaf(name, typ, attrs, dslFlattenCondition(cond)), nil
+}
+
+// parseRange parses value < subject < value
+func (p *dslParser) parseRange() (*dslNode, error) {
+	lower, err := p.expectNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	lowerOp, err := p.parseRangeOperator(cb.ConditionOperator_GT, cb.ConditionOperator_GE)
+	if err != nil {
+		return nil, err
+	}
+
+	name, typ, attrs, err := p.parseSubject()
+	if err != nil {
+		return nil, err
+	}
+
+	upperOp, err := p.parseRangeOperator(cb.ConditionOperator_LT, cb.ConditionOperator_LE)
+	if err != nil {
+		return nil, err
+	}
+
+	upper, err := p.expectNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, val := range []dslToken{lower, upper} {
+		if _, err = p.intValue(val, typ == cb.ConditionType_Latency); err != nil {
+			return nil, err
+		}
+	}
+
+	cond := &dslNode{logic: cb.LogicType_And_, children: []*dslNode{
+		{cond: &cb.ConditionMessage{Type: typ, Op: lowerOp, Value: lower.val}},
+		{cond: &cb.ConditionMessage{Type: typ, Op: upperOp, Value: upper.val}},
+	}}
+
+	return dslLeaf(name, typ, attrs, dslFlattenCondition(cond)), nil
+}
+
+func (p *dslParser) parseRangeOperator(lt, le cb.ConditionOperator) (cb.ConditionOperator, error) {
+	t := p.next()
+	if t.is("<") {
+		return lt, nil
+	} else if t.is("<=") {
+		return le, nil
+	}
+
+	return 0, p.errorf(t, "expect < or <= in range, got %q", t.text)
+}
+
+func (p *dslParser) parseSequence() (*dslNode, error) {
+	p.next()
+	p.next()
+
+	var names []string
+	maxGap := int64(-1)
+	for {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+
+		if !p.peek().is(",") {
+			break
+		}
+		p.next()
+	}
+
+	if p.peek().is(";") {
+		p.next()
+
+		if err := p.expect("gap"); err != nil {
+			return nil, err
+		} else if err = p.expect("="); err != nil {
+			return nil, err
+		}
+
+		gap, err := p.expectNumber()
+		if err != nil {
+			return nil, err
+		} else if maxGap, err = p.intValue(gap, false); err != nil {
+			return nil, err
+		} else if maxGap < 0 {
+			return nil, p.errorf(gap, "invalid gap")
+		}
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	return &dslNode{leaf: func(id, parent int64) *cb.PrerequisiteNode {
+		return cb.NewPrerequisiteSequenceNode(id, names, maxGap, parent)
+	}}, nil
+}
+
+// parseProfile parses profile(field) op value
+func (p *dslParser) parseProfile() (*dslNode, error) {
+	p.next()
+	p.next()
+
+	t := p.next()
+	if t.kind != dslName {
+		return nil, p.errorf(t, "expect profile field, got %q", t.text)
+	} else if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	path := t.text
+
+	t = p.next()
+	op, ok := dslOperators[t.text]
+	if !ok || t.kind != dslPunct {
+		return nil, p.errorf(t, "expect operator, got %q", t.text)
+	}
+
+	val, err := p.expectNumber()
+	if err != nil {
+		return nil, err
+	} else if val.unit == "ms" {
+		return nil, p.errorf(val, "unexpected unit ms")
+	}
+
+	value := val.num
+	if val.unit != "" {
+		value *= dslUnits[val.unit]
+	}
+
+	return &dslNode{leaf: func(id, parent int64) *cb.PrerequisiteNode {
+		return cb.NewPrerequisiteProfileNode(id, path, op, value, parent)
+	}}, nil
+}
+
+// dslLeaf returns the leaf node of PrerequisiteMessage_ or PrerequisiteAfterObservation_.
+// Single condition latency(name) > value is compiled into PrerequisiteEvent.Latency.
+func dslLeaf(name string, typ cb.ConditionType, attrs []*cb.AttributeCondition, condTree *cb.ConditionTree) *dslNode {
+	if typ == cb.ConditionType_NumOfInvok && len(attrs) != 0 {
+		return &dslNode{leaf: func(id, parent int64) *cb.PrerequisiteNode {
+			return cb.NewPrerequisiteMessageAttrNode(id, name, attrs, condTree, parent)
+		}}
+	} else if typ == cb.ConditionType_NumOfInvok {
+		return &dslNode{leaf: func(id, parent int64) *cb.PrerequisiteNode {
+			return cb.NewPrerequisiteMessageNode(id, name, condTree, parent, nil)
+		}}
+	}
+
+	latency := int64(0)
+	if nodes := condTree.GetNodes(); len(nodes) == 1 && nodes[0].GetMessage().GetOp() == cb.ConditionOperator_GT {
+		latency, condTree = nodes[0].Message.Value, nil
+	}
+
+	return &dslNode{leaf: func(id, parent int64) *cb.PrerequisiteNode {
+		return cb.NewPrerequisiteAfterObservationNode(id, name, latency, condTree, parent)
+	}}
+}
+
+func dslFlattenCondition(node *dslNode) *cb.ConditionTree {
+	if node == nil {
+		return nil
+	}
+
+	return cb.NewConditionTree(dslAppendCondition(nil, node, -1), nil)
+}
+
+// dslAppendCondition appends {node} and its children to {nodes} in pre-order
+func dslAppendCondition(nodes []*cb.ConditionNode, node *dslNode, parent int64) []*cb.ConditionNode {
+	if node.cond != nil {
+		return append(nodes, &cb.ConditionNode{Type: cb.ConditionNodeType_ConditionMessage_, Message: node.cond})
+	}
+
+	id := int64(len(nodes))
+	logic := cb.NewConditionLogicNode(node.logic, parent, nil)
+	logic.Logic.K = node.k
+	nodes = append(nodes, logic)
+	for _, child := range node.children {
+		logic.Logic.List = append(logic.Logic.List, int64(len(nodes)))
+		nodes = dslAppendCondition(nodes, child, id)
+	}
+
+	return nodes
+}
+
+// dslAppendPrerequisite appends {node} and its children to {nodes} in pre-order
+func dslAppendPrerequisite(nodes []*cb.PrerequisiteNode, node *dslNode, parent int64) []*cb.PrerequisiteNode {
+	id := int64(len(nodes))
+	if node.leaf != nil {
+		return append(nodes, node.leaf(id, parent))
+	}
+
+	logic := cb.NewPrerequisiteLogicNode(id, node.logic, parent, nil)
+	logic.Logic.K = node.k
+	nodes = append(nodes, logic)
+	for _, child := range node.children {
+		logic.Logic.List = append(logic.Logic.List, int64(len(nodes)))
+		nodes = dslAppendPrerequisite(nodes, child, id)
+	}
+
+	return nodes
+}
+
+// CompilePrerequisiteTree compiles the text DSL {expr} into a PrerequisiteTree, see ValidatePrerequisiteTree.
+// An event name can be referred by at most one PrerequisiteMessage_ node, as PrerequisiteTree.Index does.
+func CompilePrerequisiteTree(expr string) (*cb.PrerequisiteTree, error) {
+	tokens, err := dslLex(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dslParser{tokens: tokens}
+	node, err := p.parseLogic(0, p.parsePrerequisite)
+	if err != nil {
+		return nil, err
+	} else if t := p.peek(); t.kind != dslEOF {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+
+	tree := &cb.PrerequisiteTree{Nodes: dslAppendPrerequisite(nil, node, -1)}
+	if errs := ValidatePrerequisiteTree(tree); len(errs) != 0 {
+		return nil, errs[0]
+	}
+
+	return tree, nil
+}
+
+// MustCompilePrerequisiteTree is like CompilePrerequisiteTree but panics on error
+func MustCompilePrerequisiteTree(expr string) *cb.PrerequisiteTree {
+	tree, err := CompilePrerequisiteTree(expr)
+	if err != nil {
+		panic("reaction: CompilePrerequisiteTree(" + strconv.Quote(expr) + "): " + err.Error())
+	}
+
+	return tree
+}
+
+type dslPrinter struct {
+	sb strings.Builder
+}
+
+// printLogic prints a logic node, using {operand} to print nodes in {list}.
+// Operands are always parenthesized.
+func (p *dslPrinter) printLogic(typ cb.LogicType, k int64, list []int64, operand func(id int64) error) error {
+	if len(list) == 0 {
+		return errors.New("empty list of logic node")
+	}
+
+	sep := ""
+	switch typ {
+	case cb.LogicType_Not_:
+		if len(list) != 1 {
+			return errors.New("invalid list length for Not_")
+		}
+		p.sb.WriteString("!")
+	case cb.LogicType_KOf_:
+		p.sb.WriteString(strconv.FormatInt(k, 10) + " of {")
+		sep = ", "
+	case cb.LogicType_And_, cb.LogicType_Or_, cb.LogicType_Xor_:
+		sep = " " + dslLogicTexts[typ] + " "
+	default:
+		return errors.New("unsupported logic type")
+	}
+
+	for i, id := range list {
+		if i != 0 {
+			p.sb.WriteString(sep)
+		}
+
+		p.sb.WriteString("(")
+		if err := operand(id); err != nil {
+			return err
+		}
+		p.sb.WriteString(")")
+	}
+
+	if typ == cb.LogicType_KOf_ {
+		p.sb.WriteString("}")
+	}
+
+	return nil
+}
+
+func dslValue(val int64, typ cb.ConditionType) string {
+	if typ == cb.ConditionType_Latency {
+		return strconv.FormatInt(val, 10) + "ms"
+	}
+
+	return strconv.FormatInt(val, 10)
+}
+
+// printTerm prints a ConditionMessage of subject type {typ}, ConditionType_ is printed as {typ}
+func (p *dslPrinter) printTerm(cond *cb.ConditionMessage, typ cb.ConditionType) error {
+	op, ok := dslOperatorTexts[cond.Op]
+	if !ok {
+		return errors.New("unsupported operation type")
+	}
+
+	if cond.Type == cb.ConditionType_NumOfInvokWindow && typ == cb.ConditionType_NumOfInvok {
+		p.sb.WriteString("within " + strconv.FormatInt(cond.Window, 10) + "ms ")
+	} else if cond.Type != typ && cond.Type != cb.ConditionType_ConditionType_ {
+		return errors.New("unexpected ConditionType")
+	}
+	p.sb.WriteString(op + " " + dslValue(cond.Value, typ))
+
+	return nil
+}
+
+// dslRange returns lower and upper bounds of a range condition tree, e.g., 1 < EventC < 4
+func dslRange(nodes []*cb.ConditionNode, typ cb.ConditionType) (lower, upper *cb.ConditionMessage, ok bool) {
+	if len(nodes) != 3 || nodes[0].GetLogic().GetType() != cb.LogicType_And_ {
+		return nil, nil, false
+	} else if list := nodes[0].Logic.List; len(list) != 2 || list[0] != 1 || list[1] != 2 {
+		return nil, nil, false
+	}
+
+	lower, upper = nodes[1].GetMessage(), nodes[2].GetMessage()
+	if lower.GetType() != typ || upper.GetType() != typ {
+		return nil, nil, false
+	} else if lower.Op != cb.ConditionOperator_GT && lower.Op != cb.ConditionOperator_GE {
+		return nil, nil, false
+	} else if upper.Op != cb.ConditionOperator_LT && upper.Op != cb.ConditionOperator_LE {
+		return nil, nil, false
+	}
+
+	return lower, upper, true
+}
+
+// printConditions prints {subject} with its condition tree
+func (p *dslPrinter) printConditions(subject string, tree *cb.ConditionTree, typ cb.ConditionType) error {
+	nodes := tree.GetNodes()
+	if len(nodes) == 0 {
+		p.sb.WriteString(subject)
+
+		return nil
+	} else if len(nodes) == 1 && nodes[0].GetMessage() != nil {
+		p.sb.WriteString(subject + " ")
+
+		return p.printTerm(nodes[0].Message, typ)
+	} else if lower, upper, ok := dslRange(nodes, typ); ok {
+		lowerOp := "<"
+		if lower.Op == cb.ConditionOperator_GE {
+			lowerOp = "<="
+		}
+		p.sb.WriteString(dslValue(lower.Value, typ) + " " + lowerOp + " " + subject + " ")
+		p.sb.WriteString(dslOperatorTexts[upper.Op] + " " + dslValue(upper.Value, typ))
+
+		return nil
+	}
+
+	p.sb.WriteString(subject + "[")
+	visited := make([]bool, len(nodes))
+	var printNode func(id int64) error
+	printNode = func(id int64) error {
+		if id < 0 || id >= int64(len(nodes)) {
+			return errors.New("ConditionNode index out of range")
+		} else if visited[id] {
+			return errors.New("ConditionNode visited more than once")
+		}
+		visited[id] = true
+
+		if node := nodes[id]; node.GetMessage() != nil {
+			return p.printTerm(node.Message, typ)
+		} else if node.GetLogic() != nil {
+			return p.printLogic(node.Logic.Type, node.Logic.K, node.Logic.List, printNode)
+		}
+
+		return errors.New("unsupported ConditionNodeType")
+	}
+
+	if err := printNode(0); err != nil {
+		return err
+	}
+	p.sb.WriteString("]")
+
+	return nil
+}
+
+var dslAttributeOperatorTexts = map[cb.AttributeOperator]string{
+	cb.AttributeOperator_AttrEQ:    "=",
+	cb.AttributeOperator_AttrNE:    "!=",
+	cb.AttributeOperator_AttrIn:    "in",
+	cb.AttributeOperator_AttrNotIn: "not in",
+}
+
+// dslSubject returns the subject of a PrerequisiteMessage, e.g., EventA{rest.method = "POST"}
+func dslSubject(msg *cb.PrerequisiteMessage) (string, error) {
+	if len(msg.Attrs) == 0 {
+		return msg.Name, nil
+	}
+
+	attrs := make([]string, len(msg.Attrs))
+	for i, attr := range msg.Attrs {
+		op, ok := dslAttributeOperatorTexts[attr.GetOp()]
+		if !ok || attr.Path == nil || len(attr.Values) == 0 {
+			return "", errors.New("invalid AttributeCondition")
+		}
+
+		path := strings.Join(attr.Path.Path, ".")
+		if attr.Path.Type == cb.PathType_Application {
+			path = "_." + path
+		}
+
+		values := make([]string, len(attr.Values))
+		for j, val := range attr.Values {
+			values[j] = strconv.Quote(val)
+		}
+
+		if attr.Op == cb.AttributeOperator_AttrEQ || attr.Op == cb.AttributeOperator_AttrNE {
+			attrs[i] = path + " " + op + " " + values[0]
+		} else {
+			attrs[i] = path + " " + op + " {" + strings.Join(values, ", ") + "}"
+		}
+	}
+
+	return msg.Name + "{" + strings.Join(attrs, ", ") + "}", nil
+}
+
+// FormatPrerequisiteTree prints {tree} in the text DSL, see CompilePrerequisiteTree
+func FormatPrerequisiteTree(tree *cb.PrerequisiteTree) (string, error) {
+	nodes := tree.GetNodes()
+	if len(nodes) == 0 {
+		return "", errors.New("empty PrerequisiteTree")
+	}
+
+	p := &dslPrinter{}
+	visited := make([]bool, len(nodes))
+	var printNode func(id int64) error
+	printNode = func(id int64) error {
+		if id < 0 || id >= int64(len(nodes)) {
+			return errors.New("PrerequisiteNode index out of range")
+		} else if visited[id] {
+			return errors.New("PrerequisiteNode visited more than once")
+		}
+		visited[id] = true
+
+		switch node := nodes[id]; node.Type {
+		case cb.PrerequisiteNodeType_PrerequisiteMessage_:
+			subject, err := dslSubject(node.Message)
+			if err != nil {
+				return err
+			}
+
+			return p.printConditions(subject, node.Message.CondTree, cb.ConditionType_NumOfInvok)
+		case cb.PrerequisiteNodeType_PrerequisiteAfterObservation_:
+			subject := "latency(" + node.PrevEvent.Name + ")"
+			if len(node.PrevEvent.GetCondTree().GetNodes()) == 0 {
+				p.sb.WriteString(subject + " > " + dslValue(node.PrevEvent.Latency, cb.ConditionType_Latency))
+
+				return nil
+			}
+
+			return p.printConditions(subject, node.PrevEvent.CondTree, cb.ConditionType_Latency)
+		case cb.PrerequisiteNodeType_PrerequisiteSequence_:
+			p.sb.WriteString("seq(" + strings.Join(node.Sequence.Names, ", "))
+			if node.Sequence.MaxGap >= 0 {
+				p.sb.WriteString("; gap=" + strconv.FormatInt(node.Sequence.MaxGap, 10))
+			}
+			p.sb.WriteString(")")
+
+			return nil
+		case cb.PrerequisiteNodeType_PrerequisiteProfile_:
+			op, ok := dslOperatorTexts[node.Profile.Op]
+			if !ok {
+				return errors.New("unsupported operation type")
+			}
+			p.sb.WriteString("profile(" + node.Profile.Path + ") " + op + " " + strconv.FormatFloat(node.Profile.Value, 'f', -1, 64))
+
+			return nil
+		case cb.PrerequisiteNodeType_PrerequisiteLogic_:
+			return p.printLogic(node.Logic.Type, node.Logic.K, node.Logic.List, printNode)
+		default:
+			return errors.New("unsupported PrerequisiteNodeType")
+		}
+	}
+
+	if err := printNode(0); err != nil {
+		return "", err
+	}
+
+	return p.sb.String(), nil
+}
@@ -0,0 +1,192 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"reflect"
+	"testing"
+)
+
+var dslTests = []struct {
+	expr string
+	tree *cb.PrerequisiteTree
+}{
+	{"(EventA) && (EventB = 1)", cb.Test_PrerequisiteTree0},
+	{"((EventA) && (EventB = 1)) || (1 < EventC < 4)", cb.Test_PrerequisiteTree1},
+	{"(EventA = 1) && ((latency(EventB) >= 100ms) || (latency(EventC) > 200ms))", cb.Test_PrerequisiteTree2},
+	{"(seq(EventA, EventB; gap=1)) && (EventC)", cb.Test_PrerequisiteTree3},
+	{"EventW within 10000ms > 2", cb.Test_PrerequisiteTree4},
+	{"(EventA >= 1) && (!(EventB[!(= 2)]))", cb.Test_PrerequisiteTree5},
+	{"2 of {(EventA >= 1), (EventB >= 1), (EventC[(>= 1) ^ (>= 3)])}", cb.Test_PrerequisiteTree6},
+	{"(EventA >= 1) ^ (EventB >= 1)", cb.Test_PrerequisiteTree7},
+	{`(EventA{rest.method = "POST"} >= 1) && (EventB{_.user_id in {"u1", "u2"}} >= 1)`, cb.Test_PrerequisiteTree8},
+	{"(EventA >= 1) && (profile(hardware.cpu.percent) > 80)", cb.Test_PrerequisiteTree9},
+}
+
+func TestCompilePrerequisiteTree(t *testing.T) {
+	for i, test := range dslTests {
+		tree, err := CompilePrerequisiteTree(test.expr)
+		if err != nil {
+			t.Error("fail, test:", i, "err:", err)
+		} else if !reflect.DeepEqual(tree, test.tree) {
+			t.Error("fail, test:", i, "expect:", test.tree, "got:", tree)
+		}
+	}
+
+	// precedence, optional parentheses and units
+	tests := []struct {
+		expr string
+		tree *cb.PrerequisiteTree
+	}{
+		{"EventA && EventB == 1 || 1 < EventC < 4", cb.Test_PrerequisiteTree1},
+		{"EventA = 1 && (latency(EventB)[>= 100] || latency(EventC) > 200)", cb.Test_PrerequisiteTree2},
+		{"2 of {EventA >= 1, EventB >= 1, EventC[>= 1 ^ >= 3]}", cb.Test_PrerequisiteTree6},
+		{`EventA{rest.method == "POST"} >= 1 && EventB{_.user_id in {"u1","u2"}}[>= 1]`, cb.Test_PrerequisiteTree8},
+	}
+
+	for i, test := range tests {
+		tree, err := CompilePrerequisiteTree(test.expr)
+		if err != nil {
+			t.Error("fail, test:", i, "err:", err)
+		} else if !reflect.DeepEqual(tree, test.tree) {
+			t.Error("fail, test:", i, "expect:", test.tree, "got:", tree)
+		}
+	}
+}
+
+func TestCompilePrerequisiteTree_Profile(t *testing.T) {
+	tests := []struct {
+		expr  string
+		value float64
+	}{
+		{"profile(language.go.HeapInuse) > 1GiB", 1 << 30},
+		{"profile(language.go.HeapInuse) > 1.5MiB", 1.5 * (1 << 20)},
+		{"profile(hardware.mem.free) < 512KiB", 512 << 10},
+		{"profile(language.go.GCCPUFraction) > 0.25", 0.25},
+	}
+
+	for i, test := range tests {
+		tree, err := CompilePrerequisiteTree(test.expr)
+		if err != nil {
+			t.Error("fail, test:", i, "err:", err)
+		} else if value := tree.Nodes[0].GetProfile().GetValue(); value != test.value {
+			t.Error("fail, test:", i, "expect:", test.value, "got:", value)
+		}
+	}
+}
+
+func TestCompilePrerequisiteTree_Error(t *testing.T) {
+	tests := []string{
+		"",
+		"EventA &&",
+		"(EventA",
+		"EventA EventB",
+		"EventA = ",
+		"EventA # 1",
+		"EventA = 1ms",
+		"latency(EventA)",
+		"latency(EventA) within 10 > 1",
+		"EventA within 0 > 1",
+		"1 > EventA > 0",
+		"3 of {(EventA), (EventB)}",
+		"seq(EventA, EventB; gap=-1)",
+		"(EventA) && (EventA = 1)",
+		`EventA{rest.method}`,
+		`EventA{rest.method = POST}`,
+		`EventA{rest.method = "POST}`,
+		`EventA{rest.method in {}}`,
+		`EventA{rest.method not "POST"}`,
+		`EventA{_ = "POST"}`,
+		`EventA{rest..method = "POST"}`,
+		`EventA{_.user_id = "u1"} within 1000 > 1`,
+		`latency(EventA){_.user_id = "u1"} > 1`,
+		"EventA = 1.5",
+		"EventA = 1GiB",
+		"EventA within 1.5 > 1",
+		"1.5 of {(EventA), (EventB)}",
+		"seq(EventA, EventB; gap=1ms)",
+		"profile(hardware.cpu) > 80",
+		"profile(hardware.cpu.percent) > 80ms",
+		"profile(hardware.cpu.percent)",
+		"1 < profile(hardware.cpu.percent) < 2",
+	}
+
+	for i, expr := range tests {
+		if _, err := CompilePrerequisiteTree(expr); err == nil {
+			t.Error("fail, test:", i, "expr:", expr)
+		}
+	}
+}
+
+func TestFormatPrerequisiteTree(t *testing.T) {
+	for i, test := range dslTests {
+		expr, err := FormatPrerequisiteTree(test.tree)
+		if err != nil {
+			t.Error("fail, test:", i, "err:", err)
+		} else if expr != test.expr {
+			t.Error("fail, test:", i, "expect:", test.expr, "got:", expr)
+		}
+	}
+
+	// not in pre-order
+	tree := &cb.PrerequisiteTree{
+		Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_Or_, -1, []int64{2, 1}),
+			cb.NewPrerequisiteMessageNode(1, "EventA", nil, 0, nil),
+			cb.NewPrerequisiteSequenceNode(2, []string{"EventB", "EventC"}, -1, 0),
+		},
+	}
+	if expr, err := FormatPrerequisiteTree(tree); err != nil || expr != "(seq(EventB, EventC)) || (EventA)" {
+		t.Error("fail, err:", err, "expr:", expr)
+	}
+}
+
+func TestFormatPrerequisiteTree_Error(t *testing.T) {
+	tests := []*cb.PrerequisiteTree{
+		{},
+		{Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_And_, -1, []int64{1, 2}),
+			cb.NewPrerequisiteMessageNode(1, "EventA", nil, 0, nil),
+		}},
+		{Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_And_, -1, []int64{1, 0}),
+			cb.NewPrerequisiteMessageNode(1, "EventA", nil, 0, nil),
+		}},
+		{Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_Not_, -1, []int64{1, 2}),
+			cb.NewPrerequisiteMessageNode(1, "EventA", nil, 0, nil),
+			cb.NewPrerequisiteMessageNode(2, "EventB", nil, 0, nil),
+		}},
+		{Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteMessageNode(0, "EventA", cb.NewConditionTree([]*cb.ConditionNode{cb.Test_Condition_2_2_0}, nil), -1, nil),
+		}},
+	}
+
+	for i, tree := range tests {
+		if _, err := FormatPrerequisiteTree(tree); err == nil {
+			t.Error("fail, test:", i)
+		}
+	}
+}
+
+func TestFormatPrerequisiteTree_RoundTrip(t *testing.T) {
+	tests := []string{
+		"(!(EventA within 500ms >= 3)) && (seq(EventB, EventC))",
+		"(100ms <= latency(EventA) < 200ms) || (latency(EventB)[(< 10ms) || (2 of {(> 20ms), (!= 30ms), (< 40ms)})])",
+		"(EventA[(= 1) || (!(within 1000ms < 5))]) ^ (EventB) ^ (1 <= EventC <= 3)",
+		"(profile(hardware.mem.usedPercent) >= 80.5) || (profile(language.go.HeapInuse) > 1073741824) || (profile(hardware.net.dropin) != -1)",
+		`(1 < EventA{_.user_id not in {"u\"1", "u2"}, grpc.code != "OK"} < 4) || (EventB{_.a.b = ""})`,
+	}
+
+	for i, expr := range tests {
+		tree, err := CompilePrerequisiteTree(expr)
+		if err != nil {
+			t.Error("fail, test:", i, "err:", err)
+			continue
+		}
+
+		if got, err := FormatPrerequisiteTree(tree); err != nil || got != expr {
+			t.Error("fail, test:", i, "err:", err, "got:", got)
+		}
+	}
+}
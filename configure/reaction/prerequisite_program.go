@@ -0,0 +1,391 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"errors"
+	"time"
+)
+
+// Compiled evaluator of prerequisite trees.
+// NewPrerequisiteTree compiles the tree into a flat program of instructions with a boolean accumulator,
+// logic nodes are compiled into jumps, keeping the short-circuit order (and errors) of the recursive Check.
+
+type opcode uint8
+
+const (
+	opTrue        opcode = iota // acc = true
+	opFalse                     // acc = false
+	opError                     // return errs[a]
+	opJumpFalse                 // if !acc, jump to b
+	opJumpTrue                  // if acc, jump to b
+	opNot                       // acc = !acc
+	opClear                     // counters[a] = 0
+	opCount                     // if acc, counters[a]++
+	opXorCount                  // if acc, counters[a]++, acc = false and jump to b if counters[a] > 1
+	opXorEnd                    // acc = counters[a] == 1
+	opKOfCheck                  // acc = true and jump to b if counters[a] >= x, acc = false and jump to b if counters[a] + y < x
+	opKOfEnd                    // acc = counters[a] >= x
+	opLoadCount                 // v = number of invocations snapshot.Value[a] of event names[b]
+	opLoadLatency               // v = latency between event names[b] and ed, acc = false and jump to y if not found
+	opLT                        // acc = v < x
+	opGT                        // acc = v > x
+	opLE                        // acc = v <= x
+	opGE                        // acc = v >= x
+	opEQ                        // acc = v == x
+	opNE                        // acc = v != x
+	opCompare                   // acc = conds[a].Check(v), for NumOfInvokWindow
+	opSequence                  // acc = snapshot.Value[a] >= x
+	opProfile                   // acc = profiles[a].Check(ProfileStore.Get())
+)
+
+type instruction struct {
+	op   opcode
+	a, b int32
+	x, y int64
+}
+
+// program counters are kept on stack if no more than programCounters are nested
+const programCounters = 8
+
+type program struct {
+	code     []instruction
+	names    []string
+	conds    []*ConditionMessage
+	profiles []*PrerequisiteProfile
+	errs     []error
+	counters int // max number of nested Xor_ and KOf_ nodes
+}
+
+var errProgramInvalid = errors.New("invalid tree for compiling")
+
+type programCompiler struct {
+	p         *program
+	depth     int // guards cycles of prerequisite nodes
+	condDepth int // guards cycles of condition nodes
+	slot      int32
+}
+
+func (c *programCompiler) emit(ins instruction) int {
+	c.p.code = append(c.p.code, ins)
+
+	return len(c.p.code) - 1
+}
+
+func (c *programCompiler) emitError(err error) {
+	c.p.errs = append(c.p.errs, err)
+	c.emit(instruction{op: opError, a: int32(len(c.p.errs) - 1)})
+}
+
+// patch sets jump targets of {jumps} to the next instruction
+func (c *programCompiler) patch(jumps []int) {
+	for _, pc := range jumps {
+		c.p.code[pc].b = int32(len(c.p.code))
+	}
+}
+
+// logic compiles a logic node of {typ} over {list}, using {child} to compile nodes in {list}
+func (c *programCompiler) logic(typ cb.LogicType, k int64, list []int64, child func(id int64) error) error {
+	var jumps []int
+	switch typ {
+	case cb.LogicType_And_, cb.LogicType_Or_:
+		jump, empty := opJumpFalse, opTrue
+		if typ == cb.LogicType_Or_ {
+			jump, empty = opJumpTrue, opFalse
+		}
+
+		if len(list) == 0 {
+			c.emit(instruction{op: empty})
+
+			return nil
+		}
+
+		for i, id := range list {
+			if err := child(id); err != nil {
+				return err
+			}
+
+			if i != len(list)-1 {
+				jumps = append(jumps, c.emit(instruction{op: jump}))
+			}
+		}
+	case cb.LogicType_Not_:
+		if len(list) != 1 {
+			c.emitError(errors.New("invalid list length for Not_"))
+
+			return nil
+		}
+
+		if err := child(list[0]); err != nil {
+			return err
+		}
+		c.emit(instruction{op: opNot})
+	case cb.LogicType_Xor_, cb.LogicType_KOf_:
+		slot := c.slot
+		if c.slot++; int(c.slot) > c.p.counters {
+			c.p.counters = int(c.slot)
+		}
+
+		c.emit(instruction{op: opClear, a: slot})
+		for i, id := range list {
+			if typ == cb.LogicType_KOf_ {
+				jumps = append(jumps, c.emit(instruction{op: opKOfCheck, a: slot, x: k, y: int64(len(list) - i)}))
+			}
+
+			if err := child(id); err != nil {
+				return err
+			}
+
+			if typ == cb.LogicType_Xor_ {
+				jumps = append(jumps, c.emit(instruction{op: opXorCount, a: slot}))
+			} else {
+				c.emit(instruction{op: opCount, a: slot})
+			}
+		}
+
+		if typ == cb.LogicType_Xor_ {
+			c.emit(instruction{op: opXorEnd, a: slot})
+		} else {
+			c.emit(instruction{op: opKOfEnd, a: slot, x: k})
+		}
+		c.slot--
+	default:
+		c.emitError(errors.New("unsupported PrerequisiteLogicType"))
+	}
+
+	c.patch(jumps)
+
+	return nil
+}
+
+var programOperators = map[cb.ConditionOperator]opcode{
+	cb.ConditionOperator_LT: opLT,
+	cb.ConditionOperator_GT: opGT,
+	cb.ConditionOperator_LE: opLE,
+	cb.ConditionOperator_GE: opGE,
+	cb.ConditionOperator_EQ: opEQ,
+	cb.ConditionOperator_NE: opNE,
+}
+
+// compare compiles ConditionMessage {cond}, comparing with v of ConditionType {typ}, see ConditionMessage.Check
+func (c *programCompiler) compare(cond *cb.ConditionMessage, typ cb.ConditionType) {
+	if cond.Type == cb.ConditionType_NumOfInvokWindow {
+		c.p.conds = append(c.p.conds, (*ConditionMessage)(cond))
+		c.emit(instruction{op: opCompare, a: int32(len(c.p.conds) - 1)})
+	} else if cond.Type != cb.ConditionType_ConditionType_ && cond.Type != typ {
+		c.emitError(errors.New("unexpected ConditionType"))
+	} else if op, ok := programOperators[cond.Op]; !ok {
+		c.emitError(errors.New("unsupported operation type"))
+	} else {
+		c.emit(instruction{op: op, x: cond.Value})
+	}
+}
+
+// condition compiles node {id} of condition tree {nodes}, comparing with v of ConditionType {typ} loaded before
+func (c *programCompiler) condition(nodes []*cb.ConditionNode, id int64, typ cb.ConditionType) error {
+	if id < 0 || id >= int64(len(nodes)) || c.condDepth > len(nodes) {
+		return errProgramInvalid
+	}
+	c.condDepth++
+	defer func() { c.condDepth-- }()
+
+	node := nodes[id]
+	if node == nil || node.Type == cb.ConditionNodeType_ConditionMessage_ && node.Message == nil ||
+		node.Type == cb.ConditionNodeType_ConditionLogic_ && node.Logic == nil {
+		return errProgramInvalid
+	}
+
+	if node.Type == cb.ConditionNodeType_ConditionMessage_ {
+		c.compare(node.Message, typ)
+
+		return nil
+	} else if node.Type == cb.ConditionNodeType_ConditionLogic_ {
+		return c.logic(node.Logic.Type, node.Logic.K, node.Logic.List, func(id int64) error {
+			return c.condition(nodes, id, typ)
+		})
+	}
+
+	c.emitError(errors.New("unsupported ConditionNodeType"))
+
+	return nil
+}
+
+// node compiles node {id} of prerequisite tree {nodes}
+func (c *programCompiler) node(nodes []*cb.PrerequisiteNode, id int64) error {
+	if id < 0 || id >= int64(len(nodes)) || c.depth > len(nodes) {
+		return errProgramInvalid
+	}
+	c.depth++
+	defer func() { c.depth-- }()
+
+	node := nodes[id]
+	if node == nil {
+		return errProgramInvalid
+	} else if _, ok := prerequisiteParent(node); !ok && node.Type >= cb.PrerequisiteNodeType_PrerequisiteMessage_ &&
+		node.Type <= cb.PrerequisiteNodeType_PrerequisiteProfile_ {
+		return errProgramInvalid // missing fields
+	}
+
+	switch node.Type {
+	case cb.PrerequisiteNodeType_PrerequisiteMessage_:
+		condNodes := node.Message.GetCondTree().GetNodes()
+		if len(condNodes) == 0 {
+			c.emit(instruction{op: opTrue})
+
+			return nil
+		}
+
+		c.p.names = append(c.p.names, node.Message.Name)
+		c.emit(instruction{op: opLoadCount, a: int32(id), b: int32(len(c.p.names) - 1)})
+
+		return c.condition(condNodes, 0, cb.ConditionType_NumOfInvok)
+	case cb.PrerequisiteNodeType_PrerequisiteAfterObservation_:
+		c.p.names = append(c.p.names, node.PrevEvent.Name)
+		load := c.emit(instruction{op: opLoadLatency, b: int32(len(c.p.names) - 1)})
+
+		if condNodes := node.PrevEvent.GetCondTree().GetNodes(); len(condNodes) != 0 {
+			if err := c.condition(condNodes, 0, cb.ConditionType_Latency); err != nil {
+				return err
+			}
+		} else {
+			c.emit(instruction{op: opGT, x: node.PrevEvent.Latency})
+		}
+		c.p.code[load].y = int64(len(c.p.code))
+
+		return nil
+	case cb.PrerequisiteNodeType_PrerequisiteSequence_:
+		c.emit(instruction{op: opSequence, a: int32(id), x: int64(len(node.Sequence.Names))})
+
+		return nil
+	case cb.PrerequisiteNodeType_PrerequisiteProfile_:
+		c.p.profiles = append(c.p.profiles, (*PrerequisiteProfile)(node.Profile))
+		c.emit(instruction{op: opProfile, a: int32(len(c.p.profiles) - 1)})
+
+		return nil
+	case cb.PrerequisiteNodeType_PrerequisiteLogic_:
+		return c.logic(node.Logic.Type, node.Logic.K, node.Logic.List, func(id int64) error {
+			return c.node(nodes, id)
+		})
+	}
+
+	c.emitError(errors.New("unsupported PrerequisiteNodeType"))
+
+	return nil
+}
+
+// compileProgram returns nil if {tree} can not be compiled (e.g., index out of range, a cycle, or missing fields),
+// such trees are checked recursively, see PrerequisiteTree.check.
+func compileProgram(tree *cb.PrerequisiteTree) *program {
+	if len(tree.GetNodes()) == 0 {
+		return nil
+	}
+
+	c := &programCompiler{p: &program{}}
+	if err := c.node(tree.Nodes, 0); err != nil {
+		return nil
+	}
+
+	return c.p
+}
+
+var errProgramEventData = errors.New("PrerequisiteEvent requires EventData after observation")
+
+func (p *program) run(snapshot *PrerequisiteSnapshot, ed *cb.EventData) (bool, error) {
+	var buf [programCounters]int64
+	counters := buf[:]
+	if p.counters > programCounters {
+		counters = make([]int64, p.counters)
+	}
+
+	acc := false
+	v := ConditionValue{}
+	code := p.code
+	for pc := 0; pc < len(code); pc++ {
+		ins := &code[pc]
+		switch ins.op {
+		case opTrue:
+			acc = true
+		case opFalse:
+			acc = false
+		case opError:
+			return false, p.errs[ins.a]
+		case opJumpFalse:
+			if !acc {
+				pc = int(ins.b) - 1
+			}
+		case opJumpTrue:
+			if acc {
+				pc = int(ins.b) - 1
+			}
+		case opNot:
+			acc = !acc
+		case opClear:
+			counters[ins.a] = 0
+		case opCount:
+			if acc {
+				counters[ins.a]++
+			}
+		case opXorCount:
+			if acc {
+				if counters[ins.a]++; counters[ins.a] > 1 {
+					acc = false
+					pc = int(ins.b) - 1
+				}
+			}
+		case opXorEnd:
+			acc = counters[ins.a] == 1
+		case opKOfCheck:
+			if cnt := counters[ins.a]; cnt >= ins.x {
+				acc = true
+				pc = int(ins.b) - 1
+			} else if cnt+ins.y < ins.x {
+				acc = false
+				pc = int(ins.b) - 1
+			}
+		case opKOfEnd:
+			acc = counters[ins.a] >= ins.x
+		case opLoadCount:
+			v = ConditionValue{Type: cb.ConditionType_NumOfInvok, Value: snapshot.Value[ins.a], Name: p.names[ins.b]}
+		case opLoadLatency:
+			if ed == nil {
+				return false, errProgramEventData
+			}
+
+			prevED := ed.GetPreviousEventData(p.names[ins.b])
+			if prevED == nil {
+				acc = false
+				pc = int(ins.y) - 1
+
+				continue
+			}
+
+			v = ConditionValue{Type: cb.ConditionType_Latency, Value: (ed.Event.When.Time - prevED.Event.When.Time) / int64(time.Millisecond)}
+		case opLT:
+			acc = v.Value < ins.x
+		case opGT:
+			acc = v.Value > ins.x
+		case opLE:
+			acc = v.Value <= ins.x
+		case opGE:
+			acc = v.Value >= ins.x
+		case opEQ:
+			acc = v.Value == ins.x
+		case opNE:
+			acc = v.Value != ins.x
+		case opCompare:
+			var err error
+			if acc, err = p.conds[ins.a].Check(v); err != nil {
+				return false, err
+			}
+		case opSequence:
+			acc = snapshot.Value[ins.a] >= ins.x
+		case opProfile:
+			var err error
+			if acc, err = p.profiles[ins.a].Check(ProfileStore.Get()); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return acc, nil
+}
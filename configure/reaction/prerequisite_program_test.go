@@ -0,0 +1,179 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// randomTree appends a random subtree of depth at most {depth} to {nodes} in pre-order
+func randomTree(r *rand.Rand, nodes []*cb.PrerequisiteNode, parent int64, depth int) []*cb.PrerequisiteNode {
+	id := int64(len(nodes))
+	name := []string{"EventA", "EventB", "EventC", "EventD"}[r.Intn(4)]
+
+	switch n := r.Intn(10); {
+	case depth == 0 || n < 3:
+		return append(nodes, cb.NewPrerequisiteMessageNode(id, name, randomConditionTree(r, cb.ConditionType_NumOfInvok), parent, nil))
+	case n == 3:
+		return append(nodes, cb.NewPrerequisiteAfterObservationNode(id, name, r.Int63n(300), randomConditionTree(r, cb.ConditionType_Latency), parent))
+	case n == 4:
+		return append(nodes, cb.NewPrerequisiteSequenceNode(id, []string{name, "EventA"}, r.Int63n(3)-1, parent))
+	case n == 5:
+		path := []string{"hardware.cpu.percent", "hardware.mem.used", "language.go.HeapInuse", "unknown"}[r.Intn(4)]
+		return append(nodes, cb.NewPrerequisiteProfileNode(id, path, cb.ConditionOperator(1+r.Intn(6)), float64(r.Intn(3)*50), parent))
+	}
+
+	logic := cb.NewPrerequisiteKOfNode(id, r.Int63n(4), parent, nil)
+	logic.Logic.Type = cb.LogicType(1 + r.Intn(5))
+	nodes = append(nodes, logic)
+	for i, cnt := 0, r.Intn(4); i < cnt; i++ {
+		logic.Logic.List = append(logic.Logic.List, int64(len(nodes)))
+		nodes = randomTree(r, nodes, id, depth-1)
+	}
+
+	return nodes
+}
+
+func randomConditionTree(r *rand.Rand, typ cb.ConditionType) *cb.ConditionTree {
+	if r.Intn(3) == 0 {
+		return nil
+	}
+
+	var nodes []*cb.ConditionNode
+	var build func(parent int64, depth int)
+	build = func(parent int64, depth int) {
+		if depth == 0 || r.Intn(2) == 0 {
+			nodes = append(nodes, cb.NewConditionMessageNode(typ, cb.ConditionOperator(1+r.Intn(6)), r.Int63n(4)*50))
+			if r.Intn(10) == 0 { // unexpected type
+				nodes[len(nodes)-1].Message.Type = cb.ConditionType(3 - typ)
+			}
+
+			return
+		}
+
+		id := int64(len(nodes))
+		logic := cb.NewConditionKOfNode(r.Int63n(3), parent, nil)
+		logic.Logic.Type = cb.LogicType(1 + r.Intn(5))
+		nodes = append(nodes, logic)
+		for i, cnt := 0, r.Intn(4); i < cnt; i++ {
+			logic.Logic.List = append(logic.Logic.List, int64(len(nodes)))
+			build(id, depth-1)
+		}
+	}
+	build(-1, 2)
+
+	return cb.NewConditionTree(nodes, nil)
+}
+
+func TestPrerequisiteTree_Check_Program(t *testing.T) {
+	newED := func(name string, ms int64, prev *cb.EventData) *cb.EventData {
+		return &cb.EventData{
+			Event: &cb.EventRepresentation{
+				When:     &cb.EventWhen{Time: ms * int64(time.Millisecond)},
+				Recorder: &cb.EventRecorder{Name: name},
+			},
+			PrevEventData: prev,
+		}
+	}
+
+	defer ProfileStore.Set(ProfileStore.Get())
+	ProfileStore.Set(&cb.EnvironmentalProfile{
+		Hardware: &cb.HardwareProfile{Cpu: &cb.CPUProfile{Percent: 50}},
+		Language: &cb.LanguageProfile{Profile: &cb.LanguageProfile_Go{Go: &cb.LanguageGo{HeapInuse: 100}}},
+	})
+
+	r := rand.New(rand.NewSource(1))
+	trees := []*PrerequisiteTree{Tree0, Tree1, Tree2, Tree3, Tree4, Tree5, Tree6, Tree7, Tree8, Tree9}
+	for i := 0; i < 500; i++ {
+		trees = append(trees, NewPrerequisiteTree(&cb.PrerequisiteTree{Nodes: randomTree(r, nil, -1, 4)}))
+	}
+
+	for i, tree := range trees {
+		if tree.program == nil {
+			t.Fatal("fail, tree not compiled:", i)
+		}
+
+		for j := 0; j < 20; j++ {
+			snapshot := tree.InitializeSnapshot()
+			for k := range snapshot.Value {
+				snapshot.Value[k] = r.Int63n(4)
+			}
+
+			var ed *cb.EventData
+			if r.Intn(4) != 0 {
+				ed = newED("EventD", 300, newED("EventC", r.Int63n(300), newED("EventB", r.Int63n(300), nil)))
+			}
+
+			acc, err := tree.program.run(snapshot, ed)
+			expAcc, expErr := tree.checkRecursive(snapshot, ed)
+			if acc != expAcc || (err == nil) != (expErr == nil) || err != nil && err.Error() != expErr.Error() {
+				t.Fatal("fail, tree:", i, tree.PrerequisiteTree, "snapshot:", snapshot.Value, "expect:", expAcc, expErr, "got:", acc, err)
+			}
+		}
+	}
+}
+
+func TestPrerequisiteTree_Check_ProgramInvalid(t *testing.T) {
+	trees := []*cb.PrerequisiteTree{
+		{Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_And_, -1, []int64{1, 2}),
+			cb.NewPrerequisiteMessageNode(1, "EventA", nil, 0, nil),
+		}},
+		{Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_And_, -1, []int64{0}),
+		}},
+		{Nodes: []*cb.PrerequisiteNode{
+			cb.NewPrerequisiteMessageNode(0, "EventA", cb.NewConditionTree([]*cb.ConditionNode{
+				cb.NewConditionLogicNode(cb.LogicType_Not_, -1, []int64{1}),
+				{Type: cb.ConditionNodeType_ConditionMessage_},
+			}, nil), -1, nil),
+		}},
+	}
+
+	for i, tree := range trees {
+		if NewPrerequisiteTree(tree).program != nil {
+			t.Error("fail, invalid tree compiled:", i)
+		}
+	}
+}
+
+func TestPrerequisiteTree_Check_Allocs(t *testing.T) {
+	for i, tree := range []*PrerequisiteTree{Tree0, Tree1, Tree3, Tree5, Tree6, Tree7} {
+		snapshot := tree.InitializeSnapshot()
+		if allocs := testing.AllocsPerRun(100, func() { tree.Check(snapshot) }); allocs != 0 {
+			t.Error("fail, tree:", i, "allocs:", allocs)
+		}
+	}
+}
+
+func BenchmarkPrerequisiteTree_CheckRecursive_0(b *testing.B) {
+	snapshot := Tree0.InitializeSnapshot()
+	Tree0.UpdateSnapshot("EventA", snapshot)
+	Tree0.UpdateSnapshot("EventB", snapshot)
+
+	for i := 0; i < b.N; i++ {
+		Tree0.checkRecursive(snapshot, nil)
+	}
+}
+
+func BenchmarkPrerequisiteTree_Check_1(b *testing.B) {
+	snapshot := Tree1.InitializeSnapshot()
+	Tree1.UpdateSnapshot("EventC", snapshot)
+	Tree1.UpdateSnapshot("EventC", snapshot)
+
+	for i := 0; i < b.N; i++ {
+		Tree1.Check(snapshot)
+	}
+}
+
+func BenchmarkPrerequisiteTree_CheckRecursive_1(b *testing.B) {
+	snapshot := Tree1.InitializeSnapshot()
+	Tree1.UpdateSnapshot("EventC", snapshot)
+	Tree1.UpdateSnapshot("EventC", snapshot)
+
+	for i := 0; i < b.N; i++ {
+		Tree1.checkRecursive(snapshot, nil)
+	}
+}
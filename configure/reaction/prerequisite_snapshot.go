@@ -7,13 +7,25 @@ import (
 )
 
 func (t *PrerequisiteTree) InitializeSnapshot() *PrerequisiteSnapshot {
-	return &PrerequisiteSnapshot{
+	snapshot := &PrerequisiteSnapshot{
 		Value: make([]int64, len(t.Nodes)),
 	}
+
+	if t.IsSequence() {
+		snapshot.Order = make([]int64, len(t.Nodes))
+		snapshot.Sequence = make([]bool, len(t.Nodes))
+		for _, node := range t.Sequences {
+			snapshot.Sequence[node.Id] = true
+		}
+	}
+
+	return snapshot
 }
 
 // update PrerequisiteSnapshot:
 // 1. number of occurrence
+// 2. order of occurrence for PrerequisiteSequence_ nodes:
+//    Value is the number of matched names, Order is the Clock of the last matched name
 
 func (n *PrerequisiteNode) UpdateSnapshot(snapshot *PrerequisiteSnapshot) error {
 	if n.Type != cb.PrerequisiteNodeType_PrerequisiteMessage_ {
@@ -25,14 +37,74 @@ func (n *PrerequisiteNode) UpdateSnapshot(snapshot *PrerequisiteSnapshot) error
 	return nil
 }
 
+// UpdateSequence matches {name} observed at {clock} greedily,
+// restarts the sequence if more than MaxGap other events are observed between two consecutive names.
+// On a mismatch, it falls back to the longest matched prefix which is also a suffix by KMP {failures},
+// e.g., the 3rd EventA of EventA -> EventA -> EventA -> EventB keeps 2 names of (EventA, EventA, EventB) matched.
+// Events matching no fallback are ignored.
+func (n *PrerequisiteNode) UpdateSequence(name string, clock int64, failures []int64, snapshot *PrerequisiteSnapshot) error {
+	if n.Type != cb.PrerequisiteNodeType_PrerequisiteSequence_ {
+		return errors.New("unexpected PrerequisiteNodeType")
+	}
+
+	seq := n.Sequence
+	matched := snapshot.Value[n.Id]
+	if matched >= int64(len(seq.Names)) { // accomplished
+		return nil
+	} else if len(failures) != len(seq.Names) {
+		return errors.New("sequence failures length not match")
+	}
+
+	if matched != 0 && seq.MaxGap >= 0 && clock-snapshot.Order[n.Id]-1 > seq.MaxGap {
+		matched = 0
+	}
+
+	for matched > 0 && seq.Names[matched] != name {
+		matched = failures[matched-1]
+	}
+	if seq.Names[matched] != name {
+		return nil
+	}
+
+	snapshot.Value[n.Id] = matched + 1
+	snapshot.Order[n.Id] = clock
+
+	return nil
+}
+
+// UpdateSnapshot updates {snapshot} with an invocation of event {name} carrying no values,
+// see UpdateSnapshotWithEvent.
 func (t *PrerequisiteTree) UpdateSnapshot(name string, snapshot *PrerequisiteSnapshot) error {
+	return t.UpdateSnapshotWithEvent(name, nil, snapshot)
+}
+
+// UpdateSnapshotWithEvent updates {snapshot} with an invocation of event {name} carrying values {what},
+// the invocation is not counted by the PrerequisiteMessage_ node if any of its AttributeConditions does not hold.
+func (t *PrerequisiteTree) UpdateSnapshotWithEvent(name string, what *cb.EventWhat, snapshot *PrerequisiteSnapshot) error {
 	if snapshot == nil {
 		return errors.New("nil pointer PrerequisiteSnapshot")
 	} else if len(snapshot.Value) != len(t.Nodes) {
 		return errors.New("prerequisite length not match")
 	}
 
+	if t.IsSequence() {
+		if len(snapshot.Order) != len(t.Nodes) {
+			return errors.New("prerequisite order length not match")
+		}
+
+		snapshot.Clock++
+		for i, node := range t.Sequences {
+			if err := node.UpdateSequence(name, snapshot.Clock, t.failures[i], snapshot); err != nil {
+				return err
+			}
+		}
+	}
+
 	if node, ok := t.Index[name]; ok {
+		if !(*PrerequisiteMessage)(node.Message).CheckAttributes(what) {
+			return nil
+		}
+
 		return node.UpdateSnapshot(snapshot)
 	}
 
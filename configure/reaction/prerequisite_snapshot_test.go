@@ -1,6 +1,8 @@
 package reaction
 
 import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
 	"reflect"
 	"testing"
 )
@@ -30,6 +32,102 @@ func TestPrerequisiteTree_UpdateSnapshot_0(t *testing.T) {
 	}
 }
 
+func TestPrerequisiteTree_UpdateSnapshot_3(t *testing.T) {
+	tests := []struct {
+		events []string
+		exp    bool
+	}{
+		{[]string{"EventA", "EventB", "EventC"}, true},
+		{[]string{"EventC", "EventA", "EventD", "EventB"}, true},            // 1 other event in between
+		{[]string{"EventA", "EventD", "EventD", "EventB", "EventC"}, false}, // 2 other events in between
+		{[]string{"EventB", "EventA", "EventC"}, false},                     // EventB before EventA
+		{[]string{"EventA", "EventD", "EventD", "EventA", "EventB", "EventC"}, true},
+		{[]string{"EventA", "EventB", "EventD", "EventD", "EventC"}, true}, // accomplished sequence
+	}
+
+	for i, test := range tests {
+		snapshot := Tree3.InitializeSnapshot()
+		for _, event := range test.events {
+			if err := Tree3.UpdateSnapshot(event, snapshot); err != nil {
+				t.Error("fail, test:", i, "err:", err)
+			}
+		}
+
+		if snapshot.Clock != int64(len(test.events)) {
+			t.Error("fail, test:", i, "clock:", snapshot.Clock)
+		}
+
+		acc, err := Tree3.Check(snapshot)
+		if err != nil || acc != test.exp {
+			t.Error("fail, test:", i, "err:", err, "acc:", acc)
+		}
+	}
+}
+
+func TestPrerequisiteTree_UpdateSequence(t *testing.T) {
+	tree := NewPrerequisiteTree(&cb.PrerequisiteTree{
+		Nodes: []*cb.PrerequisiteNode{cb.NewPrerequisiteSequenceNode(0, []string{"EventA", "EventA", "EventB"}, -1, -1)},
+	})
+	if failures := tree.failures[0]; !reflect.DeepEqual(failures, []int64{0, 1, 0}) {
+		t.Error("fail, failures:", failures)
+	}
+
+	tests := []struct {
+		events []string
+		value  int64
+	}{
+		{[]string{"EventA", "EventA", "EventB"}, 3},
+		{[]string{"EventA", "EventA", "EventA", "EventB"}, 3}, // falls back to (EventA, EventA)
+		{[]string{"EventA", "EventA", "EventA", "EventA", "EventB"}, 3},
+		{[]string{"EventA", "EventB", "EventA", "EventB"}, 3}, // the 1st EventB is ignored
+		{[]string{"EventB", "EventA", "EventB"}, 1},
+		{[]string{"EventA", "EventA", "EventA"}, 2},
+	}
+
+	for i, test := range tests {
+		snapshot := tree.InitializeSnapshot()
+		for _, event := range test.events {
+			if err := tree.UpdateSnapshot(event, snapshot); err != nil {
+				t.Error("fail, test:", i, "err:", err)
+			}
+		}
+
+		if snapshot.Value[0] != test.value {
+			t.Error("fail, test:", i, "expect:", test.value, "value:", snapshot.Value[0])
+		}
+	}
+}
+
+func TestPrerequisiteTree_UpdateSnapshotWithEvent_8(t *testing.T) {
+	snapshot := Tree8.InitializeSnapshot()
+	events := []struct {
+		name string
+		what *cb.EventWhat
+		exp  bool
+	}{
+		{"EventA", newEventWhat("u1", "GET"), false},
+		{"EventB", newEventWhat("u3", "POST"), false},
+		{"EventA", nil, false},
+		{"EventA", newEventWhat("u3", "POST"), false}, // EventB not counted yet
+		{"EventB", newEventWhat("u2", "GET"), true},
+	}
+
+	for i, event := range events {
+		if err := Tree8.UpdateSnapshotWithEvent(event.name, event.what, snapshot); err != nil {
+			t.Error("fail, event:", i, "err:", err)
+		}
+
+		acc, err := Tree8.Check(snapshot)
+		if err != nil || acc != event.exp {
+			t.Error("fail, event:", i, "err:", err, "acc:", acc, "snapshot:", snapshot.Value)
+		}
+	}
+
+	if snapshot.Value[1] != 1 || snapshot.Value[2] != 1 {
+		t.Error("fail, snapshot:", snapshot.Value)
+	}
+}
+
 func BenchmarkPrerequisiteTree_UpdateSnapshot_0(b *testing.B) {
 	snapshot := Tree0.InitializeSnapshot()
 	for i := 0; i < b.N; i++ {
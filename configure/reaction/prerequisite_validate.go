@@ -0,0 +1,292 @@
+package reaction
+
+import (
+	"github.com/AleckDarcy/ContextBus/helper"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"errors"
+	"fmt"
+)
+
+// Structural validation of prerequisite trees, which are indexed without bound checks during Check.
+// Node 0 is the root, every other node is referred exactly once by the List of its parent.
+
+func validateLogic(typ cb.LogicType, k int64, list []int64) error {
+	switch typ {
+	case cb.LogicType_And_, cb.LogicType_Or_, cb.LogicType_Xor_:
+		if len(list) == 0 {
+			return fmt.Errorf("empty list for %s", typ)
+		}
+	case cb.LogicType_Not_:
+		if len(list) != 1 {
+			return fmt.Errorf("invalid list length %d for Not_", len(list))
+		}
+	case cb.LogicType_KOf_:
+		if k < 1 || k > int64(len(list)) {
+			return fmt.Errorf("k %d out of range [1, %d] for KOf_", k, len(list))
+		}
+	default:
+		return fmt.Errorf("unsupported LogicType %d", typ)
+	}
+
+	return nil
+}
+
+// validateChildren checks List of node {id}, {parents} records the parent of visited nodes
+func validateChildren(id int64, list []int64, parents []int64, parentOf func(child int64) (int64, bool)) []error {
+	var errs []error
+	for _, child := range list {
+		if child < 0 || child >= int64(len(parents)) {
+			errs = append(errs, fmt.Errorf("nodes[%d]: list index %d out of range [0, %d)", id, child, len(parents)))
+		} else if child == 0 || parents[child] != -2 {
+			errs = append(errs, fmt.Errorf("nodes[%d]: node %d referred more than once, or a cycle", id, child))
+		} else {
+			parents[child] = id
+			if parent, ok := parentOf(child); ok && parent != id {
+				errs = append(errs, fmt.Errorf("nodes[%d]: parent %d, expect %d", child, parent, id))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateReachable follows {parents} of each node up to the root, a node is unreachable
+// if it is not referred by any List, or it is in a cycle detached from the root.
+func validateReachable(parents []int64) []error {
+	var errs []error
+	for i := range parents {
+		id := int64(i)
+		for steps := 0; id >= 0 && steps <= len(parents); steps++ {
+			id = parents[id]
+		}
+
+		if id != -1 {
+			errs = append(errs, fmt.Errorf("nodes[%d]: unreachable from root", i))
+		}
+	}
+
+	return errs
+}
+
+// ValidateConditionTree returns all structural problems of {tree},
+// conditions are compared with values of {typ}, e.g., NumOfInvok for PrerequisiteMessage.
+func ValidateConditionTree(tree *cb.ConditionTree, typ cb.ConditionType) []error {
+	nodes := tree.GetNodes()
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	var errs []error
+	parents := make([]int64, len(nodes))
+	for i := range parents {
+		parents[i] = -2 // not visited
+	}
+	parents[0] = -1
+
+	parentOf := func(child int64) (int64, bool) {
+		if logic := nodes[child].GetLogic(); logic != nil {
+			return logic.Parent, true
+		}
+
+		return 0, false
+	}
+
+	for i, node := range nodes {
+		id := int64(i)
+		switch {
+		case node == nil:
+			errs = append(errs, fmt.Errorf("nodes[%d]: nil ConditionNode", i))
+		case node.Type == cb.ConditionNodeType_ConditionMessage_ && node.Message != nil:
+			msg := node.Message
+			if _, ok := cb.ConditionOperator_name[int32(msg.Op)]; !ok || msg.Op == cb.ConditionOperator_ConditionOperator_ {
+				errs = append(errs, fmt.Errorf("nodes[%d]: unsupported ConditionOperator %d", i, msg.Op))
+			}
+
+			if msg.Type == cb.ConditionType_NumOfInvokWindow && typ == cb.ConditionType_NumOfInvok {
+				if msg.Window <= 0 {
+					errs = append(errs, fmt.Errorf("nodes[%d]: window %d must be positive", i, msg.Window))
+				} else if limit := helper.WINDOW_MAX.Milliseconds(); msg.Window > limit {
+					errs = append(errs, fmt.Errorf("nodes[%d]: window %d exceeds the maximum %d", i, msg.Window, limit))
+				}
+			} else if msg.Type != typ && msg.Type != cb.ConditionType_ConditionType_ {
+				errs = append(errs, fmt.Errorf("nodes[%d]: unexpected ConditionType %s, expect %s", i, msg.Type, typ))
+			}
+		case node.Type == cb.ConditionNodeType_ConditionLogic_ && node.Logic != nil:
+			if id == 0 && node.Logic.Parent != -1 {
+				errs = append(errs, fmt.Errorf("nodes[0]: parent %d of root, expect -1", node.Logic.Parent))
+			}
+
+			if err := validateLogic(node.Logic.Type, node.Logic.K, node.Logic.List); err != nil {
+				errs = append(errs, fmt.Errorf("nodes[%d]: %s", i, err))
+			}
+
+			errs = append(errs, validateChildren(id, node.Logic.List, parents, parentOf)...)
+		default:
+			errs = append(errs, fmt.Errorf("nodes[%d]: unsupported ConditionNodeType %d or missing fields", i, node.Type))
+		}
+	}
+
+	return append(errs, validateReachable(parents)...)
+}
+
+// ValidateAttribute returns an error if {attr} is malformed
+func ValidateAttribute(attr *cb.AttributeCondition) error {
+	if attr == nil {
+		return errors.New("nil AttributeCondition")
+	} else if path := attr.Path; path == nil || len(path.Path) == 0 {
+		return errors.New("empty path")
+	} else if path.Type != cb.PathType_Application && path.Type != cb.PathType_Library {
+		return fmt.Errorf("unsupported PathType %d", path.Type)
+	} else {
+		for _, key := range path.Path {
+			if key == "" {
+				return errors.New("empty key in path")
+			}
+		}
+	}
+
+	switch attr.Op {
+	case cb.AttributeOperator_AttrEQ, cb.AttributeOperator_AttrNE:
+		if len(attr.Values) != 1 {
+			return fmt.Errorf("invalid values length %d for %s", len(attr.Values), attr.Op)
+		}
+	case cb.AttributeOperator_AttrIn, cb.AttributeOperator_AttrNotIn:
+		if len(attr.Values) == 0 {
+			return fmt.Errorf("empty values for %s", attr.Op)
+		}
+	default:
+		return fmt.Errorf("unsupported AttributeOperator %d", attr.Op)
+	}
+
+	return nil
+}
+
+// hasWindowCondition returns true if any condition of {tree} is NumOfInvokWindow
+func hasWindowCondition(tree *cb.ConditionTree) bool {
+	for _, node := range tree.GetNodes() {
+		if node.GetMessage().GetType() == cb.ConditionType_NumOfInvokWindow {
+			return true
+		}
+	}
+
+	return false
+}
+
+// prerequisiteParent returns the parent of {node}, ok is false if fields are missing
+func prerequisiteParent(node *cb.PrerequisiteNode) (parent int64, ok bool) {
+	switch node.GetType() {
+	case cb.PrerequisiteNodeType_PrerequisiteMessage_:
+		return node.Message.GetParent(), node.Message != nil
+	case cb.PrerequisiteNodeType_PrerequisiteLogic_:
+		return node.Logic.GetParent(), node.Logic != nil
+	case cb.PrerequisiteNodeType_PrerequisiteAfterObservation_:
+		return node.PrevEvent.GetParent(), node.PrevEvent != nil
+	case cb.PrerequisiteNodeType_PrerequisiteSequence_:
+		return node.Sequence.GetParent(), node.Sequence != nil
+	case cb.PrerequisiteNodeType_PrerequisiteProfile_:
+		return node.Profile.GetParent(), node.Profile != nil
+	}
+
+	return 0, false
+}
+
+// ValidatePrerequisiteTree returns all structural problems of {tree}: index bounds, cycles,
+// parent/child consistency, node fields matching node types, and conditions and attributes of leaf nodes.
+func ValidatePrerequisiteTree(tree *cb.PrerequisiteTree) []error {
+	nodes := tree.GetNodes()
+	if len(nodes) == 0 {
+		return []error{errors.New("empty PrerequisiteTree")}
+	}
+
+	for i, node := range nodes {
+		if _, ok := prerequisiteParent(node); !ok {
+			return []error{fmt.Errorf("nodes[%d]: unsupported PrerequisiteNodeType or missing fields", i)}
+		}
+	}
+
+	var errs []error
+	parents := make([]int64, len(nodes))
+	for i := range parents {
+		parents[i] = -2 // not visited
+	}
+	parents[0] = -1
+
+	parentOf := func(child int64) (int64, bool) {
+		return prerequisiteParent(nodes[child])
+	}
+
+	names := map[string]int{}
+	for i, node := range nodes {
+		id := int64(i)
+		if node.Id != id {
+			errs = append(errs, fmt.Errorf("nodes[%d]: id %d, expect %d", i, node.Id, i))
+		}
+
+		if parent, _ := prerequisiteParent(node); id == 0 && parent != -1 {
+			errs = append(errs, fmt.Errorf("nodes[0]: parent %d of root, expect -1", parent))
+		}
+
+		switch node.Type {
+		case cb.PrerequisiteNodeType_PrerequisiteMessage_:
+			if node.Message.Name == "" {
+				errs = append(errs, fmt.Errorf("nodes[%d]: empty event name", i))
+			} else if j, ok := names[node.Message.Name]; ok {
+				errs = append(errs, fmt.Errorf("nodes[%d]: duplicate event name %s of nodes[%d]", i, node.Message.Name, j))
+			} else {
+				names[node.Message.Name] = i
+			}
+
+			for _, err := range ValidateConditionTree(node.Message.CondTree, cb.ConditionType_NumOfInvok) {
+				errs = append(errs, fmt.Errorf("nodes[%d].cond_tree.%s", i, err))
+			}
+
+			for j, attr := range node.Message.Attrs {
+				if err := ValidateAttribute(attr); err != nil {
+					errs = append(errs, fmt.Errorf("nodes[%d].attrs[%d]: %s", i, j, err))
+				}
+			}
+
+			// WindowStore counts all invocations of the service, regardless of attribute values
+			if len(node.Message.Attrs) != 0 && hasWindowCondition(node.Message.CondTree) {
+				errs = append(errs, fmt.Errorf("nodes[%d]: attrs are not supported by NumOfInvokWindow conditions", i))
+			}
+		case cb.PrerequisiteNodeType_PrerequisiteAfterObservation_:
+			if node.PrevEvent.Name == "" {
+				errs = append(errs, fmt.Errorf("nodes[%d]: empty event name", i))
+			}
+
+			for _, err := range ValidateConditionTree(node.PrevEvent.CondTree, cb.ConditionType_Latency) {
+				errs = append(errs, fmt.Errorf("nodes[%d].cond_tree.%s", i, err))
+			}
+		case cb.PrerequisiteNodeType_PrerequisiteSequence_:
+			if len(node.Sequence.Names) == 0 {
+				errs = append(errs, fmt.Errorf("nodes[%d]: empty sequence", i))
+			}
+
+			for _, name := range node.Sequence.Names {
+				if name == "" {
+					errs = append(errs, fmt.Errorf("nodes[%d]: empty event name", i))
+
+					break
+				}
+			}
+		case cb.PrerequisiteNodeType_PrerequisiteProfile_:
+			if _, ok := ProfileFields[node.Profile.Path]; !ok {
+				errs = append(errs, fmt.Errorf("nodes[%d]: unsupported profile path %q", i, node.Profile.Path))
+			}
+
+			if _, ok := cb.ConditionOperator_name[int32(node.Profile.Op)]; !ok || node.Profile.Op == cb.ConditionOperator_ConditionOperator_ {
+				errs = append(errs, fmt.Errorf("nodes[%d]: unsupported ConditionOperator %d", i, node.Profile.Op))
+			}
+		case cb.PrerequisiteNodeType_PrerequisiteLogic_:
+			if err := validateLogic(node.Logic.Type, node.Logic.K, node.Logic.List); err != nil {
+				errs = append(errs, fmt.Errorf("nodes[%d]: %s", i, err))
+			}
+
+			errs = append(errs, validateChildren(id, node.Logic.List, parents, parentOf)...)
+		}
+	}
+
+	return append(errs, validateReachable(parents)...)
+}
@@ -0,0 +1,87 @@
+package reaction
+
+import (
+	"github.com/AleckDarcy/ContextBus/helper"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"testing"
+)
+
+func TestValidatePrerequisiteTree(t *testing.T) {
+	trees := []*cb.PrerequisiteTree{
+		cb.Test_PrerequisiteTree0, cb.Test_PrerequisiteTree1, cb.Test_PrerequisiteTree2, cb.Test_PrerequisiteTree3,
+		cb.Test_PrerequisiteTree4, cb.Test_PrerequisiteTree5, cb.Test_PrerequisiteTree6, cb.Test_PrerequisiteTree7,
+		cb.Test_PrerequisiteTree8, cb.Test_PrerequisiteTree9,
+	}
+
+	for i, tree := range trees {
+		if errs := ValidatePrerequisiteTree(tree); len(errs) != 0 {
+			t.Error("fail, tree:", i, "errs:", errs)
+		}
+	}
+}
+
+func TestValidatePrerequisiteTree_Error(t *testing.T) {
+	tests := []struct {
+		tree *cb.PrerequisiteTree
+		errs int
+	}{
+		{nil, 1},
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{{Type: cb.PrerequisiteNodeType_PrerequisiteMessage_}}}, 1}, // missing Message
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{ // list index out of range, node 1 unreachable
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_And_, -1, []int64{3}),
+			cb.NewPrerequisiteMessageNode(1, "EventA", nil, 0, nil),
+		}}, 2},
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{ // root referred
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_And_, -1, []int64{0, 1}),
+			cb.NewPrerequisiteMessageNode(1, "EventA", nil, 0, nil),
+		}}, 1},
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{ // cycle detached from root
+			cb.NewPrerequisiteMessageNode(0, "EventA", nil, -1, nil),
+			cb.NewPrerequisiteLogicNode(1, cb.LogicType_And_, 2, []int64{2}),
+			cb.NewPrerequisiteLogicNode(2, cb.LogicType_And_, 1, []int64{1}),
+		}}, 2},
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{ // wrong id and parent, duplicate name
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_Or_, 0, []int64{1, 2}),
+			cb.NewPrerequisiteMessageNode(2, "EventA", nil, 0, nil),
+			cb.NewPrerequisiteMessageNode(2, "EventA", nil, 1, nil),
+		}}, 4},
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{ // invalid logic
+			cb.NewPrerequisiteKOfNode(0, 3, -1, []int64{1, 2}),
+			cb.NewPrerequisiteLogicNode(1, cb.LogicType_Not_, 0, nil),
+			cb.NewPrerequisiteSequenceNode(2, nil, -1, 0),
+		}}, 3},
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{ // invalid conditions
+			cb.NewPrerequisiteMessageNode(0, "EventA", cb.NewConditionTree([]*cb.ConditionNode{
+				cb.NewConditionLogicNode(cb.LogicType_And_, -1, []int64{1, 2, 3, 4}),
+				cb.Test_Condition_2_2_0,
+				cb.NewConditionWindowNode(cb.ConditionOperator_GT, 1, 0),
+				cb.NewConditionMessageNode(cb.ConditionType_NumOfInvok, cb.ConditionOperator_ConditionOperator_, 1),
+				cb.NewConditionWindowNode(cb.ConditionOperator_GT, 1, helper.WINDOW_MAX.Milliseconds()+1),
+			}, nil), -1, nil),
+		}}, 4},
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{ // invalid attributes, attributes with window
+			cb.NewPrerequisiteMessageAttrNode(0, "EventA", []*cb.AttributeCondition{
+				nil,
+				cb.NewAttributeCondition(nil, cb.AttributeOperator_AttrEQ, []string{"a"}),
+				cb.NewAttributeCondition(cb.NewPath(cb.PathType_Library, []string{"rest", ""}), cb.AttributeOperator_AttrEQ, []string{"a"}),
+				cb.NewAttributeCondition(cb.NewPath(cb.PathType_PathType_, []string{"key"}), cb.AttributeOperator_AttrEQ, []string{"a"}),
+				cb.NewAttributeCondition(cb.NewPath(cb.PathType_Application, []string{"key"}), cb.AttributeOperator_AttrNE, []string{"a", "b"}),
+				cb.NewAttributeCondition(cb.NewPath(cb.PathType_Application, []string{"key"}), cb.AttributeOperator_AttrIn, nil),
+				cb.NewAttributeCondition(cb.NewPath(cb.PathType_Application, []string{"key"}), cb.AttributeOperator_AttributeOperator_, []string{"a"}),
+			}, cb.NewConditionTree([]*cb.ConditionNode{cb.NewConditionWindowNode(cb.ConditionOperator_GT, 1, 1000)}, nil), -1),
+		}}, 8},
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{ // invalid profiles, missing Profile
+			cb.NewPrerequisiteLogicNode(0, cb.LogicType_Or_, -1, []int64{1, 2}),
+			cb.NewPrerequisiteProfileNode(1, "hardware.cpu", cb.ConditionOperator_GT, 80, 0),
+			cb.NewPrerequisiteProfileNode(2, "hardware.cpu.percent", cb.ConditionOperator_ConditionOperator_, 80, 0),
+		}}, 2},
+		{&cb.PrerequisiteTree{Nodes: []*cb.PrerequisiteNode{{Type: cb.PrerequisiteNodeType_PrerequisiteProfile_}}}, 1},
+	}
+
+	for i, test := range tests {
+		if errs := ValidatePrerequisiteTree(test.tree); len(errs) != test.errs {
+			t.Error("fail, test:", i, "expect:", test.errs, "got:", len(errs), errs)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"sync/atomic"
+)
+
+// profileStore keeps the latest EnvironmentalProfile for PrerequisiteProfile_ nodes,
+// it is updated by background.EnvironmentProfiler every ENV_PROFILE_INTERVAL.
+type profileStore struct {
+	latest atomic.Value // *cb.EnvironmentalProfile
+}
+
+var ProfileStore = &profileStore{}
+
+func (s *profileStore) Set(ep *cb.EnvironmentalProfile) {
+	s.latest.Store(ep)
+}
+
+// Get returns the latest EnvironmentalProfile, nil if EnvironmentProfiler is not running
+func (s *profileStore) Get() *cb.EnvironmentalProfile {
+	ep, _ := s.latest.Load().(*cb.EnvironmentalProfile)
+
+	return ep
+}
+
+// profileField returns a value of {ep}, ok is false if the value is missing, e.g., CPU profiling timed out
+type profileField func(ep *cb.EnvironmentalProfile) (val float64, ok bool)
+
+func cpuField(get func(*cb.CPUProfile) float64) profileField {
+	return func(ep *cb.EnvironmentalProfile) (float64, bool) {
+		if cpu := ep.GetHardware().GetCpu(); cpu != nil {
+			return get(cpu), true
+		}
+
+		return 0, false
+	}
+}
+
+func memField(get func(*cb.MemProfile) uint64) profileField {
+	return func(ep *cb.EnvironmentalProfile) (float64, bool) {
+		if mem := ep.GetHardware().GetMem(); mem != nil {
+			return float64(get(mem)), true
+		}
+
+		return 0, false
+	}
+}
+
+func netField(get func(*cb.NetProfile) uint64) profileField {
+	return func(ep *cb.EnvironmentalProfile) (float64, bool) {
+		if net := ep.GetHardware().GetNet(); net != nil {
+			return float64(get(net)), true
+		}
+
+		return 0, false
+	}
+}
+
+func goField(get func(*cb.LanguageGo) uint64) profileField {
+	return func(ep *cb.EnvironmentalProfile) (float64, bool) {
+		if g := ep.GetLanguage().GetGo(); g != nil {
+			return float64(get(g)), true
+		}
+
+		return 0, false
+	}
+}
+
+// ProfileFields are paths of PrerequisiteProfile, named after fields of EnvironmentalProfile.
+// Note that values of hardware.net are increments during the last ENV_PROFILE_INTERVAL.
+var ProfileFields = map[string]profileField{
+	"hardware.cpu.percent": cpuField((*cb.CPUProfile).GetPercent),
+
+	"hardware.mem.total":     memField((*cb.MemProfile).GetTotal),
+	"hardware.mem.available": memField((*cb.MemProfile).GetAvailable),
+	"hardware.mem.used":      memField((*cb.MemProfile).GetUsed),
+	"hardware.mem.free":      memField((*cb.MemProfile).GetFree),
+	"hardware.mem.usedPercent": func(ep *cb.EnvironmentalProfile) (float64, bool) {
+		mem := ep.GetHardware().GetMem()
+
+		return mem.GetUsedPercent(), mem != nil
+	},
+
+	"hardware.net.bytesSent":   netField((*cb.NetProfile).GetBytesSent),
+	"hardware.net.bytesRecv":   netField((*cb.NetProfile).GetBytesRecv),
+	"hardware.net.packetsSent": netField((*cb.NetProfile).GetPacketsSent),
+	"hardware.net.packetsRecv": netField((*cb.NetProfile).GetPacketsRecv),
+	"hardware.net.errin":       netField((*cb.NetProfile).GetErrin),
+	"hardware.net.errout":      netField((*cb.NetProfile).GetErrout),
+	"hardware.net.dropin":      netField((*cb.NetProfile).GetDropin),
+	"hardware.net.dropout":     netField((*cb.NetProfile).GetDropout),
+
+	"language.go.HeapSys":     goField((*cb.LanguageGo).GetHeapSys),
+	"language.go.HeapAlloc":   goField((*cb.LanguageGo).GetHeapAlloc),
+	"language.go.HeapInuse":   goField((*cb.LanguageGo).GetHeapInuse),
+	"language.go.StackSys":    goField((*cb.LanguageGo).GetStackSys),
+	"language.go.StackInuse":  goField((*cb.LanguageGo).GetStackInuse),
+	"language.go.MSpanInuse":  goField((*cb.LanguageGo).GetMSpanInuse),
+	"language.go.MSpanSys":    goField((*cb.LanguageGo).GetMSpanSys),
+	"language.go.MCacheInuse": goField((*cb.LanguageGo).GetMCacheInuse),
+	"language.go.MCacheSys":   goField((*cb.LanguageGo).GetMCacheSys),
+	"language.go.LastGC":      goField((*cb.LanguageGo).GetLastGC),
+	"language.go.NextGC":      goField((*cb.LanguageGo).GetNextGC),
+	"language.go.GCCPUFraction": func(ep *cb.EnvironmentalProfile) (float64, bool) {
+		g := ep.GetLanguage().GetGo()
+
+		return g.GetGCCPUFraction(), g != nil
+	},
+}
@@ -12,12 +12,26 @@ type ConditionMessage cb.ConditionMessage
 type ConditionLogic cb.ConditionLogic
 type ConditionNode cb.ConditionNode
 
+type AttributeCondition cb.AttributeCondition
 type PrerequisiteMessage cb.PrerequisiteMessage
+type PrerequisiteEvent cb.PrerequisiteEvent
+type PrerequisiteSequence cb.PrerequisiteSequence
+type PrerequisiteProfile cb.PrerequisiteProfile
 type PrerequisiteLogic cb.PrerequisiteLogic
 type PrerequisiteNode cb.PrerequisiteNode
 type PrerequisiteTree struct {
 	*cb.PrerequisiteTree
 	Index map[string]*PrerequisiteNode // <event name, PrerequisiteNode>
+
+	AfterObservation bool                    // true if any PrerequisiteAfterObservation_ node exists
+	PrevEvents       []*cb.PrerequisiteEvent // PrerequisiteEvent of PrerequisiteAfterObservation_ nodes
+
+	Sequences []*PrerequisiteNode // PrerequisiteSequence_ nodes, updated on every event
+	failures  [][]int64           // KMP failure links of Sequences, see UpdateSequence
+
+	Windows []string // event names of NumOfInvokWindow conditions, counted in WindowStore
+
+	program *program // compiled evaluator, nil if the tree can not be compiled
 }
 
 // Snapshot
@@ -29,14 +43,26 @@ type PrerequisiteSnapshot cb.PrerequisiteSnapshot
 type FaultDelayParam cb.FaultDelayParam
 type TrafficBalanceParam cb.TrafficBalanceParam
 type TrafficRoutingParam cb.TrafficRoutingParam
+type SMTPParam cb.SMTPParam
+type WebhookParam cb.WebhookParam
+type EmailParam cb.EmailParam
+type CustomParam cb.CustomParam
 
 type Configure struct {
-	Name    string
-	Type    cb.ReactionType
-	Params  interface{} // isReactionConfigure_Params
-	PreTree *PrerequisiteTree
+	Name     string
+	Type     cb.ReactionType
+	Params   interface{} // isReactionConfigure_Params
+	PreTree  *PrerequisiteTree
+	Budget   *Budget                  // nil if the reaction always fires
+	Delayer  *Delayer                 // for ReactionFaultDelay
+	Notifier *Notifier                // for ReactionEmail
+	Tail     *cb.TailLoggingConfigure // for ReactionPrintLog, tail logging of the event, nil for the default
+	DryRun   bool                     // audits fires without applying the reaction
+	Mode     cb.FiringMode
 }
 
 type ReactionConfigure_FaultDelay cb.ReactionConfigure_FaultDelay
 type ReactionConfigure_TrafficBalance cb.ReactionConfigure_TrafficBalance
 type ReactionConfigure_TrafficRouting cb.ReactionConfigure_TrafficRouting
+type ReactionConfigure_Email cb.ReactionConfigure_Email
+type ReactionConfigure_Custom cb.ReactionConfigure_Custom
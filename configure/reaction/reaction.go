@@ -1,5 +1,47 @@
 package reaction
 
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+)
+
 func (c *Configure) InitializeSnapshot() *PrerequisiteSnapshot {
 	return c.PreTree.InitializeSnapshot()
 }
+
+// Fire returns true if the reaction fires at {now} (in ns) under its FiringPolicy,
+// it is called once prerequisites are accomplished.
+// Dry runs always fire without consuming the Budget, which is left to applied fires.
+func (c *Configure) Fire(now int64) bool {
+	if c.DryRun {
+		return true
+	}
+
+	return c.Budget.Acquire(now)
+}
+
+// Accomplish updates the accomplished state of {snapshot} with the check result {ok},
+// it returns true if the reaction fires under its FiringMode.
+func (c *Configure) Accomplish(ok bool, snapshot *PrerequisiteSnapshot) bool {
+	switch c.Mode {
+	case cb.FiringMode_FiringOnce:
+		if snapshot.Acc {
+			return false
+		}
+		snapshot.Acc = ok
+
+		return ok
+	case cb.FiringMode_FiringLatched:
+		snapshot.Acc = snapshot.Acc || ok
+
+		return snapshot.Acc
+	case cb.FiringMode_FiringEdge:
+		fire := ok && !snapshot.Acc
+		snapshot.Acc = ok
+
+		return fire
+	default:
+		snapshot.Acc = ok
+
+		return ok
+	}
+}
@@ -0,0 +1,41 @@
+package reaction
+
+import (
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"reflect"
+	"testing"
+)
+
+func TestConfigure_Accomplish(t *testing.T) {
+	checks := []bool{false, true, true, false, true, false}
+	tests := []struct {
+		mode  cb.FiringMode
+		fires []bool
+	}{
+		{cb.FiringMode_FiringLevel, []bool{false, true, true, false, true, false}},
+		{cb.FiringMode_FiringOnce, []bool{false, true, false, false, false, false}},
+		{cb.FiringMode_FiringLatched, []bool{false, true, true, true, true, true}},
+		{cb.FiringMode_FiringEdge, []bool{false, true, false, false, true, false}},
+	}
+
+	for _, test := range tests {
+		rac := &Configure{Mode: test.mode}
+		snapshot := &PrerequisiteSnapshot{}
+
+		fires := make([]bool, len(checks))
+		for i, ok := range checks {
+			fires[i] = rac.Accomplish(ok, snapshot)
+		}
+
+		if !reflect.DeepEqual(fires, test.fires) {
+			t.Error("fail, mode:", test.mode, "expect:", test.fires, "got:", fires)
+		}
+	}
+
+	// accomplished by another service
+	rac := &Configure{Mode: cb.FiringMode_FiringOnce}
+	if rac.Accomplish(true, &PrerequisiteSnapshot{Acc: true}) {
+		t.Error("fail, fired twice")
+	}
+}
@@ -0,0 +1,144 @@
+package reaction
+
+import (
+	cb_context "github.com/AleckDarcy/ContextBus/context"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"sync"
+)
+
+// Reactor implements a type of reaction, registered by ReactionType (see Register),
+// or by name for ReactionCustom (see RegisterCustom).
+type Reactor interface {
+	// React is called inside OnSubmission once prerequisites are accomplished and the reaction fires,
+	// the returned Decision is returned to the caller of OnSubmission.
+	React(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) *Decision
+
+	// ReactAsync is called on the observation bus after the EventData is observed,
+	// for reactions fired inside OnSubmission, and for reactions checked after observation.
+	ReactAsync(ctx *cb_context.Context, rac *Configure, ed *cb.EventData)
+}
+
+// ReactorFuncs adapts functions to Reactor.
+// A nil Sync returns a Decision without error, a nil Async does nothing.
+type ReactorFuncs struct {
+	Sync  func(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) *Decision
+	Async func(ctx *cb_context.Context, rac *Configure, ed *cb.EventData)
+}
+
+func (f *ReactorFuncs) React(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) *Decision {
+	if f.Sync == nil {
+		return rac.NewDecision(nil)
+	}
+
+	return f.Sync(ctx, rac, ed)
+}
+
+func (f *ReactorFuncs) ReactAsync(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) {
+	if f.Async != nil {
+		f.Async(ctx, rac, ed)
+	}
+}
+
+type reactorStore struct {
+	lock    sync.RWMutex
+	types   map[cb.ReactionType]Reactor
+	customs map[string]Reactor // <CustomParam.Name, Reactor>
+}
+
+var reactors = &reactorStore{
+	types:   map[cb.ReactionType]Reactor{},
+	customs: map[string]Reactor{},
+}
+
+// Register sets the Reactor of {typ}, replacing the previous one, e.g., built-in reactors.
+// It panics if {typ} is ReactionCustom, see RegisterCustom.
+func Register(typ cb.ReactionType, reactor Reactor) {
+	if typ == cb.ReactionType_ReactionCustom {
+		panic("reaction: Register ReactionCustom, use RegisterCustom instead")
+	}
+
+	reactors.lock.Lock()
+	reactors.types[typ] = reactor
+	reactors.lock.Unlock()
+}
+
+// RegisterCustom sets the Reactor of ReactionCustom reactions with CustomParam of {name}
+func RegisterCustom(name string, reactor Reactor) {
+	if name == "" {
+		panic("reaction: RegisterCustom with empty name")
+	}
+
+	reactors.lock.Lock()
+	reactors.customs[name] = reactor
+	reactors.lock.Unlock()
+}
+
+// GetCustomReactor returns the Reactor registered by RegisterCustom, nil if not found
+func GetCustomReactor(name string) Reactor {
+	reactors.lock.RLock()
+	reactor := reactors.customs[name]
+	reactors.lock.RUnlock()
+
+	return reactor
+}
+
+// GetReactor returns the Reactor of the reaction, nil if not registered
+func (c *Configure) GetReactor() Reactor {
+	if c.Type == cb.ReactionType_ReactionCustom {
+		if custom, ok := c.Params.(*cb.ReactionConfigure_Custom); ok {
+			return GetCustomReactor(custom.Custom.GetName())
+		}
+
+		return nil
+	}
+
+	reactors.lock.RLock()
+	reactor := reactors.types[c.Type]
+	reactors.lock.RUnlock()
+
+	return reactor
+}
+
+// React calls Reactor.React of the reaction.
+// Reactions without Reactor are left to the caller, e.g., ReactionTrafficRouting.
+func (c *Configure) React(ctx *cb_context.Context, ed *cb.EventData) *Decision {
+	if reactor := c.GetReactor(); reactor != nil {
+		return reactor.React(ctx, c, ed)
+	}
+
+	return c.NewDecision(nil)
+}
+
+// ReactAsync calls Reactor.ReactAsync of the reaction, if registered
+func (c *Configure) ReactAsync(ctx *cb_context.Context, ed *cb.EventData) {
+	if reactor := c.GetReactor(); reactor != nil {
+		reactor.ReactAsync(ctx, c, ed)
+	}
+}
+
+// built-in reactors of fault injection
+
+func init() {
+	Register(cb.ReactionType_ReactionFaultCrash, &ReactorFuncs{Sync: reactFaultCrash})
+	Register(cb.ReactionType_ReactionFaultDelay, &ReactorFuncs{Sync: reactFaultDelay})
+	Register(cb.ReactionType_ReactionFaultDrop, &ReactorFuncs{Sync: reactFaultDrop})
+}
+
+// reactFaultCrash returns FaultCrash as the error, OnSubmission panics after the EventData is pushed to bus
+func reactFaultCrash(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) *Decision {
+	return rac.NewDecision(rac.NewFaultCrash())
+}
+
+// reactFaultDelay ends early if the request is done, the caller handles the error of its context.Context
+func reactFaultDelay(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) *Decision {
+	ctx.Sleep(rac.Delayer.Next())
+
+	return rac.NewDecision(ctx.Err())
+}
+
+func reactFaultDrop(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) *Decision {
+	ctx.SetDropped(true)
+
+	return rac.NewDecision(rac.NewFaultDrop())
+}
@@ -0,0 +1,65 @@
+package reaction
+
+import (
+	cb_context "github.com/AleckDarcy/ContextBus/context"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"errors"
+	"testing"
+)
+
+func TestConfigure_React(t *testing.T) {
+	errCustom := errors.New("custom")
+	async := 0
+	RegisterCustom("reactor_test", &ReactorFuncs{
+		Sync: func(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) *Decision {
+			return rac.NewDecision(errCustom)
+		},
+		Async: func(ctx *cb_context.Context, rac *Configure, ed *cb.EventData) {
+			async++
+		},
+	})
+
+	custom := &Configure{
+		Name:   "EventA",
+		Type:   cb.ReactionType_ReactionCustom,
+		Params: &cb.ReactionConfigure_Custom{Custom: &cb.CustomParam{Name: "reactor_test"}},
+	}
+	if decision := custom.React(nil, nil); decision.GetErr() != errCustom || decision.GetName() != "EventA" {
+		t.Error("fail, decision:", decision)
+	}
+	custom.ReactAsync(nil, nil)
+	if async != 1 {
+		t.Error("fail, async:", async)
+	}
+
+	crash := &Configure{Name: "EventB", Type: cb.ReactionType_ReactionFaultCrash}
+	if err, ok := crash.React(nil, nil).GetErr().(*FaultCrash); !ok || err.Name != "EventB" {
+		t.Error("fail, err:", err)
+	}
+
+	// left to the caller
+	unregistered := []*Configure{
+		{Name: "EventC", Type: cb.ReactionType_ReactionTrafficRouting},
+		{Name: "EventD", Type: cb.ReactionType_ReactionCustom, Params: &cb.ReactionConfigure_Custom{Custom: &cb.CustomParam{Name: "reactor_test_unregistered"}}},
+		{Name: "EventE", Type: cb.ReactionType_ReactionCustom},
+	}
+	for _, rac := range unregistered {
+		if rac.GetReactor() != nil {
+			t.Error("fail, unexpected reactor for", rac.Name)
+		} else if decision := rac.React(nil, nil); decision.GetName() != rac.Name || decision.GetErr() != nil {
+			t.Error("fail, decision:", decision)
+		}
+		rac.ReactAsync(nil, nil)
+	}
+}
+
+func TestRegister_Custom(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("fail, panic expected")
+		}
+	}()
+
+	Register(cb.ReactionType_ReactionCustom, &ReactorFuncs{})
+}
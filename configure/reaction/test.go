@@ -9,3 +9,27 @@ var Tree0 = NewPrerequisiteTree(cb.Test_PrerequisiteTree0)
 
 // Tree1 ((EventA) && (EventB = 1)) || (1 < EventC < 4)
 var Tree1 = NewPrerequisiteTree(cb.Test_PrerequisiteTree1)
+
+// Tree2 (EventA = 1) && (latency(EventB, current) >= 100ms || latency(EventC, current) > 200ms)
+var Tree2 = NewPrerequisiteTree(cb.Test_PrerequisiteTree2)
+
+// Tree3 (EventA -> EventB, at most 1 other event in between) && (EventC)
+var Tree3 = NewPrerequisiteTree(cb.Test_PrerequisiteTree3)
+
+// Tree4 (EventW > 2 within the last 10s)
+var Tree4 = NewPrerequisiteTree(cb.Test_PrerequisiteTree4)
+
+// Tree5 (EventA) && !(EventB != 2)
+var Tree5 = NewPrerequisiteTree(cb.Test_PrerequisiteTree5)
+
+// Tree6 at least 2 of {(EventA), (EventB), (1 <= EventC < 3)}
+var Tree6 = NewPrerequisiteTree(cb.Test_PrerequisiteTree6)
+
+// Tree7 (EventA) ^ (EventB)
+var Tree7 = NewPrerequisiteTree(cb.Test_PrerequisiteTree7)
+
+// Tree8 (EventA{rest.method = "POST"}) && (EventB{_.user_id in {"u1", "u2"}})
+var Tree8 = NewPrerequisiteTree(cb.Test_PrerequisiteTree8)
+
+// Tree9 (EventA) && (CPU usage > 80%)
+var Tree9 = NewPrerequisiteTree(cb.Test_PrerequisiteTree9)
@@ -0,0 +1,91 @@
+package reaction
+
+import (
+	"github.com/AleckDarcy/ContextBus/helper"
+
+	"sync"
+)
+
+// windowCounter counts invocations of an event in a ring of time buckets
+type windowCounter struct {
+	lock    sync.Mutex
+	buckets [helper.WINDOW_BUCKET_NUM]int64
+	epochs  [helper.WINDOW_BUCKET_NUM]int64 // epoch of each bucket, stale buckets are reset lazily
+}
+
+func (c *windowCounter) add(now int64) {
+	epoch := now / int64(helper.WINDOW_BUCKET_DURATION)
+	id := epoch % helper.WINDOW_BUCKET_NUM
+
+	c.lock.Lock()
+	if c.epochs[id] != epoch {
+		c.epochs[id] = epoch
+		c.buckets[id] = 0
+	}
+	c.buckets[id]++
+	c.lock.Unlock()
+}
+
+// count returns number of invocations within (now - window, now], {window} is rounded up to buckets,
+// and clamped to helper.WINDOW_MAX, a longer one is rejected by ValidateConditionTree
+func (c *windowCounter) count(window, now int64) int64 {
+	epoch := now / int64(helper.WINDOW_BUCKET_DURATION)
+	num := (window + int64(helper.WINDOW_BUCKET_DURATION) - 1) / int64(helper.WINDOW_BUCKET_DURATION)
+	if num > helper.WINDOW_BUCKET_NUM {
+		num = helper.WINDOW_BUCKET_NUM
+	}
+
+	cnt := int64(0)
+
+	c.lock.Lock()
+	for i := int64(0); i < num; i++ {
+		id := (epoch - i) % helper.WINDOW_BUCKET_NUM
+		if c.epochs[id] == epoch-i {
+			cnt += c.buckets[id]
+		}
+	}
+	c.lock.Unlock()
+
+	return cnt
+}
+
+// windowStore holds process-level windowCounters of events used by NumOfInvokWindow conditions
+type windowStore struct {
+	lock     sync.RWMutex
+	counters map[string]*windowCounter // <event name, windowCounter>
+}
+
+var WindowStore = &windowStore{counters: map[string]*windowCounter{}}
+
+// Register starts counting invocations of event {name}
+func (s *windowStore) Register(name string) {
+	s.lock.Lock()
+	if _, ok := s.counters[name]; !ok {
+		s.counters[name] = &windowCounter{}
+	}
+	s.lock.Unlock()
+}
+
+// Add records an invocation of event {name} at {now} (in ns), unregistered events are omitted
+func (s *windowStore) Add(name string, now int64) {
+	s.lock.RLock()
+	c := s.counters[name]
+	s.lock.RUnlock()
+
+	if c != nil {
+		c.add(now)
+	}
+}
+
+// Count returns number of invocations of event {name} within {window} (in ns) before {now} (in ns)
+func (s *windowStore) Count(name string, window, now int64) int64 {
+	s.lock.RLock()
+	c := s.counters[name]
+	s.lock.RUnlock()
+
+	if c == nil {
+		return 0
+	}
+
+	return c.count(window, now)
+}
@@ -0,0 +1,50 @@
+package reaction
+
+import (
+	"github.com/AleckDarcy/ContextBus/helper"
+
+	"testing"
+	"time"
+)
+
+func TestWindowStore(t *testing.T) {
+	name := "TestWindowStore"
+	WindowStore.Add(name, 0) // unregistered
+	if cnt := WindowStore.Count(name, int64(time.Second), 0); cnt != 0 {
+		t.Error("fail, count:", cnt)
+	}
+
+	WindowStore.Register(name)
+
+	start := int64(time.Hour)
+	bucket := int64(helper.WINDOW_BUCKET_DURATION)
+	for i := int64(0); i < 10; i++ { // 1 invocation per bucket
+		WindowStore.Add(name, start+i*bucket)
+	}
+
+	now := start + 9*bucket
+	tests := []struct {
+		window int64
+		now    int64
+		exp    int64
+	}{
+		{bucket, now, 1},
+		{5 * bucket, now, 5},
+		{5*bucket - 1, now, 5}, // rounded up to buckets
+		{100 * bucket, now, 10},
+		{5 * bucket, now + 2*bucket, 3},
+		{5 * bucket, now + helper.WINDOW_BUCKET_NUM*bucket, 0}, // stale buckets
+	}
+
+	for i, test := range tests {
+		if cnt := WindowStore.Count(name, test.window, test.now); cnt != test.exp {
+			t.Error("fail, test:", i, "count:", cnt)
+		}
+	}
+
+	// ring buffer is reused after WINDOW_BUCKET_NUM buckets
+	WindowStore.Add(name, now+helper.WINDOW_BUCKET_NUM*bucket)
+	if cnt := WindowStore.Count(name, 100*bucket, now+helper.WINDOW_BUCKET_NUM*bucket); cnt != 1 {
+		t.Error("fail, count:", cnt)
+	}
+}
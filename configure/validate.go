@@ -0,0 +1,360 @@
+package configure
+
+import (
+	"github.com/AleckDarcy/ContextBus/configure/observation"
+	"github.com/AleckDarcy/ContextBus/configure/reaction"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidationError is a structural problem of Configure found by Validate
+type ValidationError struct {
+	Path string // e.g., reactions["EventC"].pre_tree
+	Err  error
+}
+
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors is the list of all problems found by Validate
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// validateReactionParams returns an error if the oneof params of {cfg} mismatches its ReactionType
+func validateReactionParams(cfg *cb.ReactionConfigure) error {
+	switch cfg.Type {
+	case cb.ReactionType_ReactionFaultCrash, cb.ReactionType_ReactionFaultDrop, cb.ReactionType_ReactionPrintLog:
+		if cfg.Params != nil {
+			return fmt.Errorf("unexpected params %T for %s", cfg.Params, cfg.Type)
+		}
+	case cb.ReactionType_ReactionFaultDelay:
+		if cfg.GetFaultDelay() == nil {
+			return fmt.Errorf("FaultDelay params required for %s", cfg.Type)
+		}
+
+		return validateFaultDelay(cfg.GetFaultDelay())
+	case cb.ReactionType_ReactionTrafficBalance:
+		if cfg.GetTrafficBalance() == nil {
+			return fmt.Errorf("TrafficBalance params required for %s", cfg.Type)
+		}
+	case cb.ReactionType_ReactionTrafficRouting:
+		if cfg.GetTrafficRouting() == nil {
+			return fmt.Errorf("TrafficRouting params required for %s", cfg.Type)
+		}
+	case cb.ReactionType_ReactionEmitEvent:
+		if cfg.GetEmitEvent().GetName() == "" {
+			return fmt.Errorf("EmitEvent params with name required for %s", cfg.Type)
+		}
+
+		for _, path := range cfg.GetEmitEvent().Paths {
+			if path == nil || len(path.Path) == 0 {
+				return errors.New("empty path")
+			} else if path.Type != cb.PathType_Application && path.Type != cb.PathType_Library {
+				return fmt.Errorf("unsupported PathType %d", path.Type)
+			}
+		}
+	case cb.ReactionType_ReactionEmail:
+		if cfg.GetEmail() == nil {
+			return fmt.Errorf("Email params required for %s", cfg.Type)
+		}
+
+		_, err := reaction.NewNotifier(cfg.GetEmail())
+
+		return err
+	case cb.ReactionType_ReactionCustom:
+		if cfg.GetCustom().GetName() == "" {
+			return fmt.Errorf("Custom params with name required for %s", cfg.Type)
+		} else if reaction.GetCustomReactor(cfg.GetCustom().GetName()) == nil {
+			return fmt.Errorf("unregistered custom reactor %q", cfg.GetCustom().GetName())
+		}
+	default:
+		return fmt.Errorf("unsupported ReactionType %d", cfg.Type)
+	}
+
+	return nil
+}
+
+// validateFaultDelay returns an error if {param} mismatches its DelayDistribution
+func validateFaultDelay(param *cb.FaultDelayParam) error {
+	if param.Ms < 0 || param.Min < 0 || param.Max < 0 || param.Stddev < 0 {
+		return errors.New("negative delay")
+	}
+
+	switch param.Distribution {
+	case cb.DelayDistribution_DelayDistribution_, cb.DelayDistribution_DelayNormal:
+	case cb.DelayDistribution_DelayExponential:
+		if param.Ms <= 0 {
+			return fmt.Errorf("ms %d must be positive for %s", param.Ms, param.Distribution)
+		}
+	case cb.DelayDistribution_DelayUniform:
+		if param.Min > param.Max {
+			return fmt.Errorf("min %d greater than max %d for %s", param.Min, param.Max, param.Distribution)
+		}
+	case cb.DelayDistribution_DelayPareto:
+		if param.Ms <= 0 {
+			return fmt.Errorf("ms %d must be positive for %s", param.Ms, param.Distribution)
+		} else if param.Shape <= 0 {
+			return fmt.Errorf("shape %v must be positive for %s", param.Shape, param.Distribution)
+		}
+	default:
+		return fmt.Errorf("unsupported DelayDistribution %d", param.Distribution)
+	}
+
+	return nil
+}
+
+// validateFiringPolicy returns an error if limits of {policy} are out of range
+func validateFiringPolicy(policy *cb.FiringPolicy) error {
+	if policy == nil {
+		return nil
+	} else if policy.Probability < 0 || policy.Probability > 1 {
+		return fmt.Errorf("probability %v out of range [0, 1]", policy.Probability)
+	} else if policy.MaxFires < 0 || policy.TotalFires < 0 {
+		return errors.New("negative max_fires or total_fires")
+	} else if policy.MaxFires != 0 && policy.Interval <= 0 {
+		return fmt.Errorf("interval %d must be positive for max_fires", policy.Interval)
+	}
+
+	return nil
+}
+
+func validateReaction(name string, cfg *cb.ReactionConfigure) ValidationErrors {
+	path := fmt.Sprintf("reactions[%q]", name)
+	if cfg == nil {
+		return ValidationErrors{{Path: path, Err: errors.New("nil ReactionConfigure")}}
+	}
+
+	var errs ValidationErrors
+	if err := validateReactionParams(cfg); err != nil {
+		errs = append(errs, &ValidationError{Path: path + ".params", Err: err})
+	}
+
+	if err := validateFiringPolicy(cfg.Policy); err != nil {
+		errs = append(errs, &ValidationError{Path: path + ".policy", Err: err})
+	}
+
+	for _, err := range reaction.ValidatePrerequisiteTree(cfg.PreTree) {
+		errs = append(errs, &ValidationError{Path: path + ".pre_tree", Err: err})
+	}
+
+	if err := validateFiringMode(cfg); err != nil {
+		errs = append(errs, &ValidationError{Path: path + ".mode", Err: err})
+	}
+
+	if cfg.Type == cb.ReactionType_ReactionEmitEvent && isAfterObservation(cfg.PreTree) {
+		errs = append(errs, &ValidationError{Path: path + ".pre_tree", Err: errors.New("synthetic events are submitted inside OnSubmission, not after observation")})
+	}
+
+	return errs
+}
+
+// isAfterObservation returns true if any prerequisite of {tree} is checked on the bus after observation
+func isAfterObservation(tree *cb.PrerequisiteTree) bool {
+	for _, node := range tree.GetNodes() {
+		if node.GetType() == cb.PrerequisiteNodeType_PrerequisiteAfterObservation_ {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateSyntheticLoop returns an error if the synthetic event of reaction {name} leads back to event {name},
+// through reactions of synthetic events.
+func validateSyntheticLoop(reactions map[string]*cb.ReactionConfigure, name string) error {
+	trace := []string{name}
+	for next := name; len(trace) <= len(reactions); {
+		rac := reactions[next]
+		if rac.GetType() != cb.ReactionType_ReactionEmitEvent {
+			return nil
+		}
+
+		next = rac.GetEmitEvent().GetName()
+		trace = append(trace, next)
+		if next == name {
+			return fmt.Errorf("synthetic event loop %s", strings.Join(trace, " -> "))
+		}
+	}
+
+	return nil
+}
+
+// validateFiringMode returns an error if the FiringMode of {cfg} is unsupported, or applied to prerequisites checked on the bus,
+// where PrerequisiteSnapshot.Acc is not written back to the request.
+func validateFiringMode(cfg *cb.ReactionConfigure) error {
+	if _, ok := cb.FiringMode_name[int32(cfg.Mode)]; !ok {
+		return fmt.Errorf("unsupported FiringMode %d", cfg.Mode)
+	} else if cfg.Mode == cb.FiringMode_FiringLevel {
+		return nil
+	}
+
+	if isAfterObservation(cfg.PreTree) {
+		return fmt.Errorf("%s with prerequisites checked after observation", cfg.Mode)
+	}
+
+	return nil
+}
+
+// validateSpanStart returns an error if the tracing of event {name} does not start a span
+func validateSpanStart(observations map[string]*cb.ObservationConfigure, name string) error {
+	if name == "" {
+		return errors.New("empty event name")
+	} else if cfg, ok := observations[name]; !ok {
+		return fmt.Errorf("event %q not found", name)
+	} else if !cfg.GetTracing().GetStart() {
+		return fmt.Errorf("event %q does not start a span", name)
+	}
+
+	return nil
+}
+
+// validateLogging validates the encoder, severities, and the FileConfigure required by LogOutType File
+func validateLogging(path string, cfg *cb.LoggingConfigure) ValidationErrors {
+	var errs ValidationErrors
+	if _, ok := cb.LogEncoderType_name[int32(cfg.Encoder)]; !ok {
+		errs = append(errs, &ValidationError{Path: path + ".encoder", Err: fmt.Errorf("unsupported LogEncoderType %d", cfg.Encoder)})
+	}
+
+	if _, ok := cb.Severity_name[int32(cfg.Level)]; !ok {
+		errs = append(errs, &ValidationError{Path: path + ".level", Err: fmt.Errorf("unsupported Severity %d", cfg.Level)})
+	}
+	if _, ok := cb.Severity_name[int32(cfg.MinLevel)]; !ok {
+		errs = append(errs, &ValidationError{Path: path + ".min_level", Err: fmt.Errorf("unsupported Severity %d", cfg.MinLevel)})
+	}
+
+	if cfg.Out == cb.LogOutType_File {
+		if _, err := observation.NewFileWriter(cfg.File); err != nil {
+			errs = append(errs, &ValidationError{Path: path + ".file", Err: err})
+		}
+	}
+
+	return errs
+}
+
+func validateObservation(observations map[string]*cb.ObservationConfigure, name string) ValidationErrors {
+	path := fmt.Sprintf("observations[%q]", name)
+	cfg := observations[name]
+	if cfg == nil {
+		return ValidationErrors{{Path: path, Err: errors.New("nil ObservationConfigure")}}
+	}
+
+	var errs ValidationErrors
+	if _, ok := cb.ObservationType_name[int32(cfg.Type)]; !ok {
+		errs = append(errs, &ValidationError{Path: path + ".type", Err: fmt.Errorf("unsupported ObservationType %d", cfg.Type)})
+	}
+
+	if tracing := cfg.Tracing; tracing != nil {
+		if tracing.Start && tracing.ParentName != "" {
+			if err := validateSpanStart(observations, tracing.ParentName); err != nil {
+				errs = append(errs, &ValidationError{Path: path + ".tracing.parent_name", Err: err})
+			}
+		}
+
+		if tracing.End {
+			if err := validateSpanStart(observations, tracing.PrevEventName); err != nil {
+				errs = append(errs, &ValidationError{Path: path + ".tracing.prev_event_name", Err: err})
+			}
+		}
+	}
+
+	if logging := cfg.Logging; logging != nil {
+		errs = append(errs, validateLogging(path+".logging", logging)...)
+	}
+
+	if tail := cfg.Tail; tail != nil {
+		if tail.Logging == nil {
+			errs = append(errs, &ValidationError{Path: path + ".tail.logging", Err: errors.New("nil LoggingConfigure")})
+		} else {
+			errs = append(errs, validateLogging(path+".tail.logging", tail.Logging)...)
+		}
+		if tail.Slow < 0 {
+			errs = append(errs, &ValidationError{Path: path + ".tail.slow", Err: fmt.Errorf("negative slow %d", tail.Slow)})
+		}
+		for i, cond := range tail.Errors {
+			if err := reaction.ValidateAttribute(cond); err != nil {
+				errs = append(errs, &ValidationError{Path: fmt.Sprintf("%s.tail.errors[%d]", path, i), Err: err})
+			}
+		}
+	}
+
+	for i, metric := range cfg.Metrics {
+		metricPath := fmt.Sprintf("%s.metrics[%d]", path, i)
+		if metric == nil {
+			errs = append(errs, &ValidationError{Path: metricPath, Err: errors.New("nil MetricsConfigure")})
+
+			continue
+		} else if _, ok := cb.MetricType_name[int32(metric.Type)]; !ok || metric.Type == cb.MetricType_MetricType_ {
+			errs = append(errs, &ValidationError{Path: metricPath + ".type", Err: fmt.Errorf("unsupported MetricType %d", metric.Type)})
+
+			continue
+		}
+
+		if !observation.MetricVecStore.HasOpts(metric.Type, metric.OptsId) {
+			errs = append(errs, &ValidationError{Path: metricPath + ".opts_id", Err: fmt.Errorf("%s vec not found for Opts %d", metric.Type, metric.OptsId)})
+		}
+
+		if metric.Type == cb.MetricType_Histogram {
+			if _, ok := observations[metric.PrevName]; !ok {
+				errs = append(errs, &ValidationError{Path: metricPath + ".prev_name", Err: fmt.Errorf("event %q not found", metric.PrevName)})
+			}
+		}
+	}
+
+	return errs
+}
+
+// Validate returns ValidationErrors with all structural problems of {cfg}, or nil if {cfg} is valid.
+// Prometheus Opts are looked up in observation.MetricVecStore, which should be set before.
+func Validate(cfg *cb.Configure) error {
+	if cfg == nil {
+		return ValidationErrors{{Path: "configure", Err: errors.New("nil Configure")}}
+	}
+
+	names := make([]string, 0, len(cfg.Reactions))
+	for name := range cfg.Reactions {
+		names = append(names, name)
+	}
+	sort.Strings(names) // for deterministic error lists
+
+	var errs ValidationErrors
+	for _, name := range names {
+		errs = append(errs, validateReaction(name, cfg.Reactions[name])...)
+
+		if err := validateSyntheticLoop(cfg.Reactions, name); err != nil {
+			errs = append(errs, &ValidationError{Path: fmt.Sprintf("reactions[%q].params", name), Err: err})
+		}
+	}
+
+	names = names[:0]
+	for name := range cfg.Observations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		errs = append(errs, validateObservation(cfg.Observations, name)...)
+	}
+
+	if len(errs) != 0 {
+		return errs
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package configure
+
+import (
+	"github.com/AleckDarcy/ContextBus/configure/observation"
+	"github.com/AleckDarcy/ContextBus/configure/reaction"
+	cb "github.com/AleckDarcy/ContextBus/proto"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"errors"
+	"testing"
+)
+
+func init() {
+	observation.MetricVecStore.Set(&cb.PrometheusConfiguration{
+		Counters: []*cb.PrometheusOpts{
+			{Id: 801, Name: "validate_test_counter", Help: "counter for validation tests", LabelNames: []string{"handler"}},
+		},
+		Histograms: []*cb.PrometheusHistogramOpts{
+			{Id: 802, Name: "validate_test_histogram", Help: "histogram for validation tests", Buckets: prometheus.DefBuckets},
+		},
+	})
+
+	reaction.RegisterCustom("validate_test", &reaction.ReactorFuncs{})
+}
+
+func TestValidate(t *testing.T) {
+	cfg := &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventC": {
+				Type:    cb.ReactionType_ReactionFaultDelay,
+				Params:  &cb.ReactionConfigure_FaultDelay{FaultDelay: &cb.FaultDelayParam{Ms: 100}},
+				PreTree: cb.Test_PrerequisiteTree1,
+				Policy:  &cb.FiringPolicy{Probability: 0.5, MaxFires: 10, Interval: 1000, TotalFires: 100},
+				Mode:    cb.FiringMode_FiringOnce,
+			},
+			"EventD": {
+				Type:    cb.ReactionType_ReactionPrintLog,
+				PreTree: cb.Test_PrerequisiteTree2,
+			},
+			"EventE": {
+				Type: cb.ReactionType_ReactionFaultDelay,
+				Params: &cb.ReactionConfigure_FaultDelay{FaultDelay: &cb.FaultDelayParam{
+					Ms: 100, Distribution: cb.DelayDistribution_DelayPareto, Shape: 1.5, Max: 10000, Seed: 1,
+				}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventF": {
+				Type:    cb.ReactionType_ReactionCustom,
+				Params:  &cb.ReactionConfigure_Custom{Custom: &cb.CustomParam{Name: "validate_test"}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventG": {
+				Type: cb.ReactionType_ReactionEmail,
+				Params: &cb.ReactionConfigure_Email{Email: &cb.EmailParam{
+					Webhook: &cb.WebhookParam{Url: "http://localhost/alerts"}, Subject: "{{.Name}} fired", DedupeWindow: 60000,
+				}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventH": {
+				Type:    cb.ReactionType_ReactionEmitEvent,
+				Params:  &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{Name: "EventI", Paths: []*cb.Path{cb.Test_Path_Rest_Method}}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventI": {
+				Type:    cb.ReactionType_ReactionEmitEvent,
+				Params:  &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{Name: "EventJ"}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+		},
+		Observations: map[string]*cb.ObservationConfigure{
+			"EventA-starts": {
+				Type:    cb.ObservationType_ObservationStart,
+				Tracing: &cb.TracingConfigure{Start: true, SpanName: "EventA"},
+				Metrics: []*cb.MetricsConfigure{{Type: cb.MetricType_Counter, OptsId: 801}},
+			},
+			"EventA-ends": {
+				Type:    cb.ObservationType_ObservationEnd,
+				Tracing: &cb.TracingConfigure{End: true, SpanName: "EventA", PrevEventName: "EventA-starts"},
+				Metrics: []*cb.MetricsConfigure{{Type: cb.MetricType_Histogram, OptsId: 802, PrevName: "EventA-starts"}},
+				Tail: &cb.TailLoggingConfigure{
+					Logging: &cb.LoggingConfigure{Out: cb.LogOutType_File, File: &cb.FileConfigure{Path: "/var/log/cb/tail.log", MaxSize: 1 << 20}},
+					Slow:    500,
+					Errors:  []*cb.AttributeCondition{cb.NewAttributeCondition(cb.Test_Path_Rest_Method, cb.AttributeOperator_AttrEQ, []string{"DELETE"})},
+				},
+			},
+		},
+	}
+
+	if err := Validate(cfg); err != nil {
+		t.Error("fail, err:", err)
+	}
+}
+
+func TestValidate_Error(t *testing.T) {
+	cfg := &cb.Configure{
+		Reactions: map[string]*cb.ReactionConfigure{
+			"EventA": nil,
+			"EventB": {
+				Type:    cb.ReactionType_ReactionFaultDelay,
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventC": {
+				Type:    cb.ReactionType_ReactionFaultCrash,
+				Params:  &cb.ReactionConfigure_FaultDelay{FaultDelay: &cb.FaultDelayParam{Ms: 100}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventD": {
+				Type:   cb.ReactionType_ReactionFaultDrop,
+				Policy: &cb.FiringPolicy{MaxFires: 10},
+			},
+			"EventE": {
+				Type: cb.ReactionType_ReactionFaultDelay,
+				Params: &cb.ReactionConfigure_FaultDelay{FaultDelay: &cb.FaultDelayParam{
+					Distribution: cb.DelayDistribution_DelayUniform, Min: 200, Max: 100,
+				}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventF": {
+				Type:    cb.ReactionType_ReactionCustom,
+				Params:  &cb.ReactionConfigure_Custom{Custom: &cb.CustomParam{Name: "validate_test_unregistered"}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventG": {
+				Type: cb.ReactionType_ReactionEmail,
+				Params: &cb.ReactionConfigure_Email{Email: &cb.EmailParam{
+					Webhook: &cb.WebhookParam{Url: "http://localhost/alerts"}, Body: "{{.Name",
+				}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventH": {
+				Type:    cb.ReactionType_ReactionPrintLog,
+				PreTree: cb.Test_PrerequisiteTree2,
+				Mode:    cb.FiringMode_FiringEdge,
+			},
+			"EventI": {
+				Type:    cb.ReactionType_ReactionPrintLog,
+				PreTree: cb.Test_PrerequisiteTree0,
+				Mode:    cb.FiringMode(9),
+			},
+			"EventJ": {
+				Type:    cb.ReactionType_ReactionEmitEvent,
+				Params:  &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{Name: "EventK"}},
+				PreTree: cb.Test_PrerequisiteTree2,
+			},
+			"EventK": {
+				Type:    cb.ReactionType_ReactionEmitEvent,
+				Params:  &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{Name: "EventJ"}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventL": {
+				Type:    cb.ReactionType_ReactionEmitEvent,
+				Params:  &cb.ReactionConfigure_EmitEvent{EmitEvent: &cb.EmitEventParam{}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventM": {
+				Type: cb.ReactionType_ReactionFaultDelay,
+				Params: &cb.ReactionConfigure_FaultDelay{FaultDelay: &cb.FaultDelayParam{
+					Distribution: cb.DelayDistribution_DelayExponential,
+				}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+			"EventN": {
+				Type: cb.ReactionType_ReactionFaultDelay,
+				Params: &cb.ReactionConfigure_FaultDelay{FaultDelay: &cb.FaultDelayParam{
+					Distribution: cb.DelayDistribution_DelayPareto, Shape: 1.5, Max: 10000,
+				}},
+				PreTree: cb.Test_PrerequisiteTree0,
+			},
+		},
+		Observations: map[string]*cb.ObservationConfigure{
+			"EventA-starts": {
+				Type:    cb.ObservationType_ObservationStart,
+				Tracing: &cb.TracingConfigure{SpanName: "EventA"},
+				Metrics: []*cb.MetricsConfigure{{Type: cb.MetricType_Counter, OptsId: 899}},
+			},
+			"EventA-ends": {
+				Type:    cb.ObservationType_ObservationEnd,
+				Tracing: &cb.TracingConfigure{End: true, SpanName: "EventA", PrevEventName: "EventA-starts"},
+				Metrics: []*cb.MetricsConfigure{{Type: cb.MetricType_Histogram, OptsId: 802, PrevName: "EventA-begins"}},
+			},
+			"EventB-starts": {
+				Type:    cb.ObservationType_ObservationStart,
+				Tracing: &cb.TracingConfigure{Start: true, SpanName: "EventB", ParentName: "EventB-parent"},
+			},
+			"EventB-ends": {
+				Type:    cb.ObservationType_ObservationEnd,
+				Logging: &cb.LoggingConfigure{Out: cb.LogOutType_File, Encoder: cb.LogEncoderType(100), MinLevel: cb.Severity(100)},
+				Tail: &cb.TailLoggingConfigure{
+					Slow:   -1,
+					Errors: []*cb.AttributeCondition{cb.NewAttributeCondition(cb.Test_Path_Rest_Method, cb.AttributeOperator_AttrEQ, nil)},
+				},
+			},
+		},
+	}
+
+	expect := []string{
+		`reactions["EventA"]`,
+		`reactions["EventB"].params`,
+		`reactions["EventC"].params`,
+		`reactions["EventD"].policy`,
+		`reactions["EventD"].pre_tree`,
+		`reactions["EventE"].params`,
+		`reactions["EventF"].params`,
+		`reactions["EventG"].params`,
+		`reactions["EventH"].mode`,
+		`reactions["EventI"].mode`,
+		`reactions["EventJ"].pre_tree`,
+		`reactions["EventJ"].params`,
+		`reactions["EventK"].params`,
+		`reactions["EventL"].params`,
+		`reactions["EventM"].params`,
+		`reactions["EventN"].params`,
+		`observations["EventA-ends"].tracing.prev_event_name`,
+		`observations["EventA-ends"].metrics[0].prev_name`,
+		`observations["EventA-starts"].metrics[0].opts_id`,
+		`observations["EventB-ends"].logging.encoder`,
+		`observations["EventB-ends"].logging.min_level`,
+		`observations["EventB-ends"].logging.file`,
+		`observations["EventB-ends"].tail.logging`,
+		`observations["EventB-ends"].tail.slow`,
+		`observations["EventB-ends"].tail.errors[0]`,
+		`observations["EventB-starts"].tracing.parent_name`,
+	}
+
+	err := Validate(cfg)
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatal("fail, err:", err)
+	} else if len(errs) != len(expect) {
+		t.Fatal("fail, expect:", len(expect), "got:", len(errs), errs)
+	}
+
+	for i, err := range errs {
+		if err.Path != expect[i] {
+			t.Error("fail, expect:", expect[i], "got:", err)
+		}
+	}
+
+	if err := Store.SetConfigureChecked(800, cfg); err == nil {
+		t.Error("fail, invalid Configure accepted")
+	} else if Store.GetConfigure(800) != Store.GetDefault() {
+		t.Error("fail, invalid Configure stored")
+	}
+}
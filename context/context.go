@@ -1,9 +1,11 @@
 package context
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/AleckDarcy/ContextBus/helper"
 	cb "github.com/AleckDarcy/ContextBus/proto"
 	"github.com/AleckDarcy/ContextBus/third-party/github.com/opentracing/opentracing-go"
 )
@@ -121,6 +123,12 @@ type Context struct {
 	span   *cb.SpanMetadata
 
 	timestamp int64
+
+	dropped bool // set by ReactionFaultDrop, the in-flight request or message should be discarded
+
+	ctx context.Context // of the in-flight request, for interrupting ReactionFaultDelay
+
+	synthetic []string // names of nested synthetic events being submitted by ReactionEmitEvent
 }
 
 func NewContext(reqCtx *RequestContext, eveCtx *EventContext) *Context {
@@ -140,7 +148,7 @@ func (c *Context) Payload() *cb.Payload {
 	return &cb.Payload{
 		RequestId: c.reqCtx.requestID,
 		ConfigId:  c.reqCtx.configureID,
-		Snapshots: c.eveCtx.snapshots,
+		Snapshots: c.eveCtx.snapshots.Clone(),
 		Addition:  nil,
 		Parent:    c.span,
 		MType:     cb.MessageType_Message_Request,
@@ -148,6 +156,39 @@ func (c *Context) Payload() *cb.Payload {
 	}
 }
 
+// ResponsePayload generates Payload for grpc responses,
+// Addition carries events witnessed by the current handler and the latest accomplished states, see MergePayload.
+func (c *Context) ResponsePayload() *cb.Payload {
+	if c == nil {
+		return nil
+	}
+
+	addition := c.eveCtx.offsetSnapshots.Clone()
+	for name, snapshot := range addition.GetSnapshots() {
+		snapshot.Acc = c.eveCtx.snapshots.GetPrerequisiteSnapshot(name).GetAcc()
+	}
+
+	return &cb.Payload{
+		RequestId: c.reqCtx.requestID,
+		ConfigId:  c.reqCtx.configureID,
+		Addition:  addition,
+		MType:     cb.MessageType_Message_Response,
+	}
+}
+
+// MergePayload merges events witnessed by the callee into snapshots of the caller,
+// and into offset snapshots if the caller is a callee as well, for its own response.
+func (c *Context) MergePayload(pay *cb.Payload) *Context {
+	if c == nil || pay == nil {
+		return c
+	}
+
+	c.eveCtx.snapshots.MergeOffset(pay.Addition)
+	c.eveCtx.offsetSnapshots.MergeOffset(pay.Addition)
+
+	return c
+}
+
 func (c *Context) SetTimestamp() {
 	c.timestamp = time.Now().UnixNano()
 }
@@ -156,6 +197,83 @@ func (c *Context) GetTimestamp() int64 {
 	return c.timestamp
 }
 
+// EnterSynthetic returns false if submitting the synthetic event {name} loops,
+// i.e., {name} is being submitted, or too many synthetic events are nested. See ExitSynthetic.
+func (c *Context) EnterSynthetic(name string) bool {
+	if len(c.synthetic) >= helper.SYNTHETIC_EVENT_DEPTH_MAX {
+		return false
+	}
+
+	for _, synthetic := range c.synthetic {
+		if synthetic == name {
+			return false
+		}
+	}
+
+	c.synthetic = append(c.synthetic, name)
+
+	return true
+}
+
+// ExitSynthetic is called after the synthetic event entered by EnterSynthetic is submitted
+func (c *Context) ExitSynthetic() {
+	if n := len(c.synthetic); n != 0 {
+		c.synthetic = c.synthetic[:n-1]
+	}
+}
+
+// SetDropped is written by ReactionFaultDrop
+func (c *Context) SetDropped(dropped bool) *Context {
+	c.dropped = dropped
+
+	return c
+}
+
+// IsDropped returns true if the caller should discard the in-flight request or message
+func (c *Context) IsDropped() bool {
+	return c.dropped
+}
+
+// SetContext is written by network APIs on receiving requests, with the context.Context of the request
+func (c *Context) SetContext(ctx context.Context) *Context {
+	c.ctx = ctx
+
+	return c
+}
+
+// Err returns the error of the request's context.Context, nil if it is not done or not set
+func (c *Context) Err() error {
+	if c.ctx == nil {
+		return nil
+	}
+
+	return c.ctx.Err()
+}
+
+// Sleep pauses for {d}, returns false if the request's context.Context is done before
+func (c *Context) Sleep(d time.Duration) bool {
+	var done <-chan struct{} // nil channel never receives
+	if c.ctx != nil {
+		if done = c.ctx.Done(); c.ctx.Err() != nil {
+			return false
+		}
+	}
+
+	if d <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-done:
+		return false
+	}
+}
+
 func (c *Context) GetRequestContext() *RequestContext {
 	return c.reqCtx
 }
@@ -7,6 +7,9 @@ const CPU_PROFILE_DURATION = time.Second
 const CPU_PROFILE_DURATION_MAX = 2 * CPU_PROFILE_DURATION
 
 const BUS_OBSERVATION_QUEUE_INTERVAL = time.Second
+const WINDOW_BUCKET_DURATION = 100 * time.Millisecond
+const WINDOW_BUCKET_NUM = 600
+const WINDOW_MAX = WINDOW_BUCKET_NUM * WINDOW_BUCKET_DURATION // max sliding window
 const EventMetadata_Timeout = 5 * time.Second
 
 const TIME_FORMAT_DEFAULT = time.RFC3339
@@ -14,4 +17,6 @@ const TIME_FORMAT_RFC3339 = time.RFC3339
 const TIME_FORMAT_RFC3339Nano = time.RFC3339Nano
 
 const PREREQUISITE_ACCOMPLISHED = -1
+const SYNTHETIC_EVENT_DEPTH_MAX = 8 // max number of nested synthetic events of ReactionEmitEvent
+const NOTIFIER_QUEUE_SIZE = 64      // max number of notifications queued by a reaction.Notifier
 const CONFIGURE_ID_DEFAULT = -1
@@ -1,6 +1,11 @@
 package helper
 
-import "strconv"
+import (
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
 
 type jsonEncoder struct{}
 
@@ -30,14 +35,85 @@ func (e *jsonEncoder) AppendKey(dst []byte, key string) []byte {
 	return append(e.AppendString(dst, key), ':')
 }
 
+// noEscape[c] is true if ASCII character c is appended to JSON strings as it is
+var noEscape = func() (table [utf8.RuneSelf]bool) {
+	for c := ' '; c < utf8.RuneSelf; c++ {
+		table[c] = c != '"' && c != '\\'
+	}
+
+	return
+}()
+
+const hex = "0123456789abcdef"
+
+// AppendString appends {str} as a quoted JSON string,
+// invalid UTF-8 bytes are replaced with U+FFFD as encoding/json does.
 func (e *jsonEncoder) AppendString(dst []byte, str string) []byte {
 	dst = append(dst, '"')
-	// todo: check escaped
-	dst = append(dst, str...)
+
+	// fast path: nothing to escape
+	i := 0
+	for ; i < len(str); i++ {
+		if c := str[i]; c >= utf8.RuneSelf || !noEscape[c] {
+			break
+		}
+	}
+	if i == len(str) {
+		dst = append(dst, str...)
+
+		return append(dst, '"')
+	}
+
+	dst = e.appendEscaped(append(dst, str[:i]...), str[i:])
 
 	return append(dst, '"')
 }
 
+func (e *jsonEncoder) appendEscaped(dst []byte, str string) []byte {
+	start := 0 // start of the pending unescaped bytes
+	for i := 0; i < len(str); {
+		c := str[i]
+		if c < utf8.RuneSelf {
+			if noEscape[c] {
+				i++
+
+				continue
+			}
+
+			dst = append(dst, str[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			start = i
+
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(str[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, str[start:i]...)
+			dst = append(dst, "\ufffd"...)
+			i += size
+			start = i
+
+			continue
+		}
+		i += size
+	}
+
+	return append(dst, str[start:]...)
+}
+
 func (e *jsonEncoder) AppendIDs(dst []byte, reqID, eveID uint64) []byte {
 	dst = append(dst, '"')
 	dst = append(dst, "RequestID "...)
@@ -49,11 +125,61 @@ func (e *jsonEncoder) AppendIDs(dst []byte, reqID, eveID uint64) []byte {
 }
 
 func (e *jsonEncoder) AppendUint(dst []byte, val uint64) []byte {
-	dst = append(dst, strconv.FormatUint(val, 10)...)
+	return strconv.AppendUint(dst, val, 10)
+}
+
+func (e *jsonEncoder) AppendInt(dst []byte, val int64) []byte {
+	return strconv.AppendInt(dst, val, 10)
+}
+
+// AppendFloat appends {val} as a JSON number, or a string of NaN, +Inf or -Inf which are not numbers in JSON
+func (e *jsonEncoder) AppendFloat(dst []byte, val float64) []byte {
+	switch {
+	case math.IsNaN(val):
+		return append(dst, `"NaN"`...)
+	case math.IsInf(val, 1):
+		return append(dst, `"+Inf"`...)
+	case math.IsInf(val, -1):
+		return append(dst, `"-Inf"`...)
+	}
+
+	// formatted as encoding/json does
+	format, abs := byte('f'), math.Abs(val)
+	if abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	dst = strconv.AppendFloat(dst, val, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		if n := len(dst); n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
 
 	return dst
 }
 
+func (e *jsonEncoder) AppendBool(dst []byte, val bool) []byte {
+	return strconv.AppendBool(dst, val)
+}
+
+// AppendTime appends {t} as a JSON string in {format}
+func (e *jsonEncoder) AppendTime(dst []byte, t time.Time, format string) []byte {
+	dst = append(dst, '"')
+	start := len(dst)
+	dst = t.AppendFormat(dst, format)
+	for _, c := range dst[start:] {
+		if c >= utf8.RuneSelf || !noEscape[c] { // escapes literal text of {format}
+			dst = e.appendEscaped(dst[:start], string(dst[start:]))
+
+			break
+		}
+	}
+
+	return append(dst, '"')
+}
+
 func (e *jsonEncoder) AppendTags(dst []byte, tags map[string]string) []byte {
 	dst = e.BeginObject(dst)
 	for key, value := range tags {
@@ -0,0 +1,109 @@
+package helper
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJSONEncoder_AppendString(t *testing.T) {
+	tests := []struct {
+		str    string
+		expect string
+	}{
+		{"", `""`},
+		{"received message from senderA", `"received message from senderA"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{`C:\path`, `"C:\\path"`},
+		{"line1\nline2\r\n\tend", `"line1\nline2\r\n\tend"`},
+		{"\x00\x1f\x7f", `"\u0000\u001f` + "\x7f" + `"`},
+		{"héllo, 世界", `"héllo, 世界"`},
+		{"bad\xffutf8\xc3", "\"bad\ufffdutf8\ufffd\""},
+	}
+
+	for i, test := range tests {
+		if got := string(JSONEncoder.AppendString(nil, test.str)); got != test.expect {
+			t.Errorf("fail, test: %d, expect: %s, got: %s", i, test.expect, got)
+		}
+	}
+}
+
+func TestJSONEncoder_AppendTyped(t *testing.T) {
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	buf := JSONEncoder.BeginObject(nil)
+	buf = JSONEncoder.AppendKey(buf, "int")
+	buf = JSONEncoder.AppendInt(buf, -42)
+	buf = JSONEncoder.AppendKey(buf, "uint")
+	buf = JSONEncoder.AppendUint(buf, math.MaxUint64)
+	buf = JSONEncoder.AppendKey(buf, "float")
+	buf = JSONEncoder.AppendFloat(buf, 3.25)
+	buf = JSONEncoder.AppendKey(buf, "small")
+	buf = JSONEncoder.AppendFloat(buf, 1e-9)
+	buf = JSONEncoder.AppendKey(buf, "nan")
+	buf = JSONEncoder.AppendFloat(buf, math.NaN())
+	buf = JSONEncoder.AppendKey(buf, "inf")
+	buf = JSONEncoder.AppendFloat(buf, math.Inf(-1))
+	buf = JSONEncoder.AppendKey(buf, "bool")
+	buf = JSONEncoder.AppendBool(buf, true)
+	buf = JSONEncoder.AppendKey(buf, "time")
+	buf = JSONEncoder.AppendTime(buf, ts, time.RFC3339)
+	buf = JSONEncoder.AppendKey(buf, "quoted")
+	buf = JSONEncoder.AppendTime(buf, ts, `2006 "Jan"`)
+	buf = JSONEncoder.EndObject(buf)
+
+	expect := `{"int":-42,"uint":18446744073709551615,"float":3.25,"small":1e-9,"nan":"NaN","inf":"-Inf","bool":true,` +
+		`"time":"2021-01-02T03:04:05Z","quoted":"2021 \"Jan\""}`
+	if string(buf) != expect {
+		t.Errorf("fail, expect: %s, got: %s", expect, buf)
+	} else if !json.Valid(buf) {
+		t.Error("fail, invalid JSON:", string(buf))
+	}
+}
+
+func FuzzJSONEncoder_AppendString(f *testing.F) {
+	for _, seed := range []string{"", "plain", `"quoted"`, "back\\slash", "new\nline", "\x00\x01\x1f", "\u2028", "世界", "\xff\xfe", "\xe4\xb8"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, str string) {
+		buf := JSONEncoder.BeginObject(nil)
+		buf = JSONEncoder.AppendKey(buf, str)
+		buf = JSONEncoder.AppendString(buf, str)
+		buf = JSONEncoder.EndObject(buf)
+
+		got := map[string]string{}
+		if err := json.Unmarshal(buf, &got); err != nil {
+			t.Fatalf("fail, str: %q, buf: %s, err: %v", str, buf, err)
+		}
+
+		// invalid UTF-8 is replaced by encoding/json in the same way
+		expect := ""
+		if raw, err := json.Marshal(str); err != nil {
+			t.Fatal("fail, err:", err)
+		} else if err = json.Unmarshal(raw, &expect); err != nil {
+			t.Fatal("fail, err:", err)
+		}
+
+		if len(got) != 1 || got[expect] != expect {
+			t.Errorf("fail, str: %q, expect: %q, got: %q", str, expect, got)
+		}
+	})
+}
+
+func BenchmarkJSONEncoder_AppendString(b *testing.B) {
+	buf := make([]byte, 0, 128)
+
+	b.Run("plain", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			JSONEncoder.AppendString(buf[:0], "received message from senderA, a tag not found")
+		}
+	})
+
+	b.Run("escaped", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			JSONEncoder.AppendString(buf[:0], "received \"message\" from senderA\n\ta tag not found")
+		}
+	})
+}
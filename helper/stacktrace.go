@@ -1 +1,212 @@
 package helper
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+const SIZE_OF_UINTPTR = int(unsafe.Sizeof(uintptr(0)))
+const MAX_CALLERS_LEN = 50
+
+// callerFrame is a symbolized program counter with its marshalled frame
+type callerFrame struct {
+	frame runtime.Frame
+	json  string // {"func":"f1","line":"196","source":"stacktrace_test.go"}
+	text  string // github.com/AleckDarcy/ContextBus/helper.f1()\n\t/path/to/stacktrace_test.go:196\n
+}
+
+type callerFrameStore struct {
+	store map[uintptr]*callerFrame
+	lock  sync.RWMutex
+}
+
+// CallerFrameStore caches symbolized program counters
+var CallerFrameStore = &callerFrameStore{store: map[uintptr]*callerFrame{}}
+
+func (s *callerFrameStore) jsonMarshaller(frame *runtime.Frame) string {
+	function, source := frame.Function, frame.File
+	if id := strings.LastIndex(function, "("); id >= 0 {
+		function = function[id:]
+	} else {
+		function = function[strings.LastIndex(function, ".")+1:]
+	}
+	source = source[strings.LastIndex(source, "/")+1:]
+
+	buf := JSONEncoder.BeginObject(make([]byte, 0, 64))
+	buf = JSONEncoder.AppendKey(buf, "func")
+	buf = JSONEncoder.AppendString(buf, function)
+	buf = JSONEncoder.AppendKey(buf, "line")
+	buf = JSONEncoder.AppendString(buf, strconv.Itoa(frame.Line))
+	buf = JSONEncoder.AppendKey(buf, "source")
+	buf = JSONEncoder.AppendString(buf, source)
+
+	return string(JSONEncoder.EndObject(buf))
+}
+
+func (s *callerFrameStore) goMarshaller(frame *runtime.Frame) string {
+	return frame.Function + "()\n\t" + frame.File + ":" + strconv.Itoa(frame.Line) + "\n"
+}
+
+// Get returns the frames of {pcs}, symbolizing uncached ones
+func (s *callerFrameStore) Get(pcs []uintptr) []*callerFrame {
+	callers := make([]*callerFrame, len(pcs))
+	ok, newPCSFlag := false, false
+	s.lock.RLock()
+	for i, pc := range pcs {
+		callers[i], ok = s.store[pc]
+		newPCSFlag = newPCSFlag || !ok
+	}
+	s.lock.RUnlock()
+
+	if newPCSFlag {
+		newPCS := make([]int, 0, len(pcs))
+		for i, pc := range pcs {
+			if callers[i] == nil {
+				frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+				callers[i] = &callerFrame{
+					frame: frame,
+					json:  s.jsonMarshaller(&frame),
+					text:  s.goMarshaller(&frame),
+				}
+				newPCS = append(newPCS, i)
+			}
+		}
+
+		s.lock.Lock()
+		for _, i := range newPCS {
+			s.store[pcs[i]] = callers[i]
+		}
+		s.lock.Unlock()
+	}
+
+	return callers
+}
+
+type stacktraceStore struct {
+	json map[string][]byte
+	text map[string][]byte
+	lock sync.RWMutex
+	pool chan []uintptr
+}
+
+// StacktraceStore caches marshalled stacktraces by their program counters
+var StacktraceStore = &stacktraceStore{
+	json: map[string][]byte{},
+	text: map[string][]byte{},
+	pool: make(chan []uintptr, 1000),
+}
+
+// jsonMarshaller marshals {callers} as a JSON array
+func (s *stacktraceStore) jsonMarshaller(callers []*callerFrame) []byte {
+	if len(callers) == 0 {
+		return []byte("[]")
+	}
+
+	bfLen := len(callers) + 1
+	for _, caller := range callers {
+		bfLen += len(caller.json)
+	}
+	bytes := make([]byte, bfLen)
+	bytes[0] = '['
+	head := 1
+	for _, caller := range callers {
+		head += copy(bytes[head:], caller.json)
+		bytes[head] = ','
+		head++
+	}
+	bytes[head-1] = ']'
+
+	return bytes
+}
+
+// goMarshaller marshals {callers} like runtime/debug.Stack
+func (s *stacktraceStore) goMarshaller(callers []*callerFrame) []byte {
+	bfLen := 0
+	for _, caller := range callers {
+		bfLen += len(caller.text)
+	}
+	bytes := make([]byte, bfLen)
+	head := 0
+	for _, caller := range callers {
+		head += copy(bytes[head:], caller.text)
+	}
+
+	return bytes
+}
+
+func (s *stacktraceStore) get(store map[string][]byte, pcs []uintptr, marshaller func([]*callerFrame) []byte) []byte {
+	pcsBytes := pcsToBytes(pcs)
+
+	s.lock.RLock()
+	bytes, ok := store[BytesToString(pcsBytes)]
+	s.lock.RUnlock()
+
+	if !ok {
+		bytes = marshaller(CallerFrameStore.Get(pcs))
+
+		s.lock.Lock()
+		store[string(pcsBytes)] = bytes
+		s.lock.Unlock()
+	}
+
+	return bytes
+}
+
+// Get returns the stacktrace of {pcs} as a JSON array, the result is cached and must not be modified
+func (s *stacktraceStore) Get(pcs []uintptr) []byte {
+	return s.get(s.json, pcs, s.jsonMarshaller)
+}
+
+// GetText returns the stacktrace of {pcs} like runtime/debug.Stack, the result is cached and must not be modified
+func (s *stacktraceStore) GetText(pcs []uintptr) []byte {
+	return s.get(s.text, pcs, s.goMarshaller)
+}
+
+func pcsToBytes(pcs []uintptr) []byte {
+	if len(pcs) == 0 {
+		return nil
+	}
+
+	return unsafe.Slice((*byte)(unsafe.Pointer(&pcs[0])), len(pcs)*SIZE_OF_UINTPTR)
+}
+
+// CaptureStacktrace returns program counters of the stack of the caller, skipping {skip} more frames.
+// It is cheap compared with symbolization, pcs are expected to be returned by ReleaseStacktrace.
+func CaptureStacktrace(skip int) []uintptr {
+	var pcs []uintptr
+	select {
+	case pcs = <-StacktraceStore.pool:
+	default:
+		pcs = make([]uintptr, MAX_CALLERS_LEN)
+	}
+
+	num := runtime.Callers(skip+2, pcs[:MAX_CALLERS_LEN])
+
+	return pcs[:num]
+}
+
+// ReleaseStacktrace recycles {pcs} from CaptureStacktrace
+func ReleaseStacktrace(pcs []uintptr) {
+	if cap(pcs) < MAX_CALLERS_LEN {
+		return
+	}
+
+	select {
+	case StacktraceStore.pool <- pcs[:MAX_CALLERS_LEN]:
+		// recycled
+	default:
+		// dropped
+	}
+}
+
+// GetStacktrace returns the stacktrace of the caller as a JSON array
+func GetStacktrace() []byte {
+	pcs := CaptureStacktrace(1)
+	bytes := StacktraceStore.Get(pcs)
+	ReleaseStacktrace(pcs)
+
+	return bytes
+}
@@ -7,6 +7,7 @@ import (
 
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -28,17 +29,56 @@ func (m *PrerequisiteSnapshot) Clone() *PrerequisiteSnapshot {
 	n := &PrerequisiteSnapshot{
 		Value: make([]int64, len(m.Value)),
 		Acc:   m.Acc,
+		Clock: m.Clock,
 	}
 
 	copy(n.Value, m.Value)
 
+	if m.Order != nil {
+		n.Order = make([]int64, len(m.Order))
+		copy(n.Order, m.Order)
+	}
+
+	if m.Sequence != nil {
+		n.Sequence = make([]bool, len(m.Sequence))
+		copy(n.Sequence, m.Sequence)
+	}
+
 	return n
 }
 
+// CopySequence copies the state of PrerequisiteSequence_ nodes from {src}, i.e., the clock, values and orders.
+// The value of a sequence node is the length of the matched prefix, which is not additive.
+func (m *PrerequisiteSnapshot) CopySequence(src *PrerequisiteSnapshot) {
+	if m == nil || src == nil || len(m.Sequence) == 0 ||
+		len(src.Value) != len(m.Value) || len(src.Order) != len(m.Order) || len(src.Sequence) != len(m.Sequence) {
+		return
+	}
+
+	for i, seq := range m.Sequence {
+		if seq {
+			m.Value[i] = src.Value[i]
+			m.Order[i] = src.Order[i]
+		}
+	}
+	m.Clock = src.Clock
+}
+
+// MergeOffset merges {src} witnessed by another service:
+// values of PrerequisiteMessage_ nodes are added, the state of PrerequisiteSequence_ nodes is replaced,
+// see CopySequence, and the accomplished state is replaced, which may be reset by the other service, e.g., FiringEdge.
 func (m *PrerequisiteSnapshot) MergeOffset(src *PrerequisiteSnapshot) {
+	if m == nil || src == nil || len(src.Value) != len(m.Value) {
+		return
+	}
+
 	for i := 0; i < len(m.Value); i++ {
-		m.Value[i] += src.Value[i]
+		if i >= len(m.Sequence) || !m.Sequence[i] {
+			m.Value[i] += src.Value[i]
+		}
 	}
+	m.CopySequence(src)
+	m.Acc = src.Acc
 }
 
 func (m *PrerequisiteSnapshots) Clone() *PrerequisiteSnapshots {
@@ -65,6 +105,10 @@ func (m *PrerequisiteSnapshots) GetPrerequisiteSnapshot(name string) *Prerequisi
 }
 
 func (m *PrerequisiteSnapshots) MergeOffset(src *PrerequisiteSnapshots) {
+	if m == nil || src == nil {
+		return
+	}
+
 	for name, dstS := range m.Snapshots {
 		dstS.MergeOffset(src.Snapshots[name])
 	}
@@ -86,6 +130,10 @@ func (m *Attributes) Clone() *Attributes {
 }
 
 func (m *Attributes) GetValue(path []string) (string, error) {
+	if m == nil {
+		return "", errors.New("value not found")
+	}
+
 	attrs := m.Attrs
 
 	for i, name := range path {
@@ -211,11 +259,46 @@ func (m *EventWhere) Merge(where *EventWhere) *EventWhere {
 		if m.Stacktrace == "" {
 			m.Stacktrace = where.Stacktrace
 		}
+		if m.Codebase == nil {
+			m.Codebase = where.Codebase
+		}
 	}
 
 	return m
 }
 
+// Caller returns "file:line" of the code base, or "" if unknown
+func (m *CodeBaseInfo) Caller() string {
+	if m == nil || m.File == "" {
+		return ""
+	}
+
+	return m.File + ":" + strconv.FormatInt(m.Line, 10)
+}
+
+// Level returns the name of the severity in log entries, "info" if not set
+func (x Severity) Level() string {
+	switch x {
+	case Severity_SeverityDebug:
+		return "debug"
+	case Severity_SeverityWarn:
+		return "warn"
+	case Severity_SeverityError:
+		return "error"
+	}
+
+	return "info"
+}
+
+// Or returns {severity} if x is not set
+func (x Severity) Or(severity Severity) Severity {
+	if x == Severity_Severity_ {
+		return severity
+	}
+
+	return x
+}
+
 func (m *EventRecorder) Merge(recorder *EventRecorder) *EventRecorder {
 	if recorder != nil {
 		if m.Type == EventRecorderType_EventRecorderType_ {
@@ -224,13 +307,18 @@ func (m *EventRecorder) Merge(recorder *EventRecorder) *EventRecorder {
 		if m.Name == "" {
 			m.Name = recorder.Name
 		}
+		if m.Severity == Severity_Severity_ {
+			m.Severity = recorder.Severity
+		}
 	}
 
 	return m
 }
 
 func (m *EventMessage) GetValue(path []string) (string, error) {
-	if len(path) == 0 {
+	if m == nil {
+		return "", errors.New("no application message")
+	} else if len(path) == 0 {
 		return "", errors.New("invalid Path length for EventMessage")
 	} else if len(path) == 1 && path[0] == "__message__" {
 		return m.Message, nil
@@ -409,7 +497,7 @@ func (m *EventRepresentation) WithWhen(when *EventWhen) *EventWhen {
 }
 
 func (m *EventRepresentation) WithWhere(where *EventWhere) *EventWhere {
-	if m.When == nil {
+	if m.Where == nil {
 		if where == nil {
 			m.Where = &EventWhere{}
 		} else {
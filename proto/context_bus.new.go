@@ -7,6 +7,13 @@ func NewConditionMessageNode(typ ConditionType, op ConditionOperator, val int64)
 	}
 }
 
+func NewConditionWindowNode(op ConditionOperator, val int64, window int64) *ConditionNode {
+	return &ConditionNode{
+		Type:    ConditionNodeType_ConditionMessage_,
+		Message: &ConditionMessage{Type: ConditionType_NumOfInvokWindow, Op: op, Value: val, Window: window},
+	}
+}
+
 func NewConditionLogicNode(typ LogicType, parent int64, list []int64) *ConditionNode {
 	return &ConditionNode{
 		Type:  ConditionNodeType_ConditionLogic_,
@@ -14,6 +21,13 @@ func NewConditionLogicNode(typ LogicType, parent int64, list []int64) *Condition
 	}
 }
 
+func NewConditionKOfNode(k int64, parent int64, list []int64) *ConditionNode {
+	return &ConditionNode{
+		Type:  ConditionNodeType_ConditionLogic_,
+		Logic: &ConditionLogic{Type: LogicType_KOf_, K: k, Parent: parent, List: list},
+	}
+}
+
 func NewConditionTree(nodes []*ConditionNode, leafIDs []int64) *ConditionTree {
 	return &ConditionTree{Nodes: nodes, LeafIDs: leafIDs}
 }
@@ -22,6 +36,18 @@ func NewPrerequisiteMessage(name string, condTree *ConditionTree, parent int64,
 	return &PrerequisiteMessage{Name: name, CondTree: condTree, Parent: parent}
 }
 
+func NewPrerequisiteEvent(name string, latency int64, condTree *ConditionTree, parent int64) *PrerequisiteEvent {
+	return &PrerequisiteEvent{Name: name, Latency: latency, CondTree: condTree, Parent: parent}
+}
+
+func NewPrerequisiteSequence(names []string, maxGap int64, parent int64) *PrerequisiteSequence {
+	return &PrerequisiteSequence{Names: names, MaxGap: maxGap, Parent: parent}
+}
+
+func NewPrerequisiteProfile(path string, op ConditionOperator, value float64, parent int64) *PrerequisiteProfile {
+	return &PrerequisiteProfile{Path: path, Op: op, Value: value, Parent: parent}
+}
+
 func NewPrerequisiteLogic(typ LogicType, parent int64, list []int64) *PrerequisiteLogic {
 	return &PrerequisiteLogic{Type: typ, Parent: parent, List: list}
 }
@@ -33,6 +59,38 @@ func NewPrerequisiteMessageNode(id int64, name string, condTree *ConditionTree,
 		Message: NewPrerequisiteMessage(name, condTree, parent, list)}
 }
 
+// NewPrerequisiteMessageAttrNode returns a PrerequisiteMessage_ node counting invocations that match all {attrs}
+func NewPrerequisiteMessageAttrNode(id int64, name string, attrs []*AttributeCondition, condTree *ConditionTree, parent int64) *PrerequisiteNode {
+	node := NewPrerequisiteMessageNode(id, name, condTree, parent, nil)
+	node.Message.Attrs = attrs
+
+	return node
+}
+
+func NewPrerequisiteAfterObservationNode(id int64, name string, latency int64, condTree *ConditionTree, parent int64) *PrerequisiteNode {
+	return &PrerequisiteNode{
+		Id:        id,
+		Type:      PrerequisiteNodeType_PrerequisiteAfterObservation_,
+		PrevEvent: NewPrerequisiteEvent(name, latency, condTree, parent),
+	}
+}
+
+func NewPrerequisiteSequenceNode(id int64, names []string, maxGap int64, parent int64) *PrerequisiteNode {
+	return &PrerequisiteNode{
+		Id:       id,
+		Type:     PrerequisiteNodeType_PrerequisiteSequence_,
+		Sequence: NewPrerequisiteSequence(names, maxGap, parent),
+	}
+}
+
+func NewPrerequisiteProfileNode(id int64, path string, op ConditionOperator, value float64, parent int64) *PrerequisiteNode {
+	return &PrerequisiteNode{
+		Id:      id,
+		Type:    PrerequisiteNodeType_PrerequisiteProfile_,
+		Profile: NewPrerequisiteProfile(path, op, value, parent),
+	}
+}
+
 func NewPrerequisiteLogicNode(id int64, typ LogicType, parent int64, list []int64) *PrerequisiteNode {
 	return &PrerequisiteNode{
 		Id:    id,
@@ -41,6 +99,18 @@ func NewPrerequisiteLogicNode(id int64, typ LogicType, parent int64, list []int6
 	}
 }
 
+func NewPrerequisiteKOfNode(id int64, k int64, parent int64, list []int64) *PrerequisiteNode {
+	return &PrerequisiteNode{
+		Id:    id,
+		Type:  PrerequisiteNodeType_PrerequisiteLogic_,
+		Logic: &PrerequisiteLogic{Type: LogicType_KOf_, K: k, Parent: parent, List: list},
+	}
+}
+
+func NewAttributeCondition(path *Path, op AttributeOperator, values []string) *AttributeCondition {
+	return &AttributeCondition{Path: path, Op: op, Values: values}
+}
+
 func NewPath(typ PathType, path []string) *Path {
 	return &Path{Type: typ, Path: path}
 }
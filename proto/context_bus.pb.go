@@ -14,8 +14,11 @@ It has these top-level messages:
 	ConditionLogic
 	ConditionNode
 	ConditionTree
+	AttributeCondition
 	PrerequisiteMessage
 	PrerequisiteEvent
+	PrerequisiteSequence
+	PrerequisiteProfile
 	PrerequisiteLogic
 	PrerequisiteNode
 	PrerequisiteTree
@@ -24,12 +27,20 @@ It has these top-level messages:
 	FaultDelayParam
 	TrafficBalanceParam
 	TrafficRoutingParam
+	SMTPParam
+	WebhookParam
+	EmailParam
+	EmitEventParam
+	CustomParam
+	FiringPolicy
 	ReactionConfigure
 	Path
 	AttributeConfigure
 	TimestampConfigure
 	StackTraceConfigure
+	FileConfigure
 	LoggingConfigure
+	TailLoggingConfigure
 	TracingConfigure
 	MetricsConfigure
 	ObservationConfigure
@@ -124,20 +135,23 @@ func (ConditionOperator) EnumDescriptor() ([]byte, []int) { return fileDescripto
 type ConditionType int32
 
 const (
-	ConditionType_ConditionType_ ConditionType = 0
-	ConditionType_NumOfInvok     ConditionType = 1
-	ConditionType_Latency        ConditionType = 2
+	ConditionType_ConditionType_   ConditionType = 0
+	ConditionType_NumOfInvok       ConditionType = 1
+	ConditionType_Latency          ConditionType = 2
+	ConditionType_NumOfInvokWindow ConditionType = 3
 )
 
 var ConditionType_name = map[int32]string{
 	0: "ConditionType_",
 	1: "NumOfInvok",
 	2: "Latency",
+	3: "NumOfInvokWindow",
 }
 var ConditionType_value = map[string]int32{
-	"ConditionType_": 0,
-	"NumOfInvok":     1,
-	"Latency":        2,
+	"ConditionType_":   0,
+	"NumOfInvok":       1,
+	"Latency":          2,
+	"NumOfInvokWindow": 3,
 }
 
 func (x ConditionType) String() string {
@@ -175,17 +189,26 @@ const (
 	LogicType_LogicType_ LogicType = 0
 	LogicType_And_       LogicType = 1
 	LogicType_Or_        LogicType = 2
+	LogicType_Not_       LogicType = 3
+	LogicType_Xor_       LogicType = 4
+	LogicType_KOf_       LogicType = 5
 )
 
 var LogicType_name = map[int32]string{
 	0: "LogicType_",
 	1: "And_",
 	2: "Or_",
+	3: "Not_",
+	4: "Xor_",
+	5: "KOf_",
 }
 var LogicType_value = map[string]int32{
 	"LogicType_": 0,
 	"And_":       1,
 	"Or_":        2,
+	"Not_":       3,
+	"Xor_":       4,
+	"KOf_":       5,
 }
 
 func (x LogicType) String() string {
@@ -200,6 +223,8 @@ const (
 	PrerequisiteNodeType_PrerequisiteMessage_          PrerequisiteNodeType = 1
 	PrerequisiteNodeType_PrerequisiteLogic_            PrerequisiteNodeType = 2
 	PrerequisiteNodeType_PrerequisiteAfterObservation_ PrerequisiteNodeType = 3
+	PrerequisiteNodeType_PrerequisiteSequence_         PrerequisiteNodeType = 4
+	PrerequisiteNodeType_PrerequisiteProfile_          PrerequisiteNodeType = 5
 )
 
 var PrerequisiteNodeType_name = map[int32]string{
@@ -207,12 +232,16 @@ var PrerequisiteNodeType_name = map[int32]string{
 	1: "PrerequisiteMessage_",
 	2: "PrerequisiteLogic_",
 	3: "PrerequisiteAfterObservation_",
+	4: "PrerequisiteSequence_",
+	5: "PrerequisiteProfile_",
 }
 var PrerequisiteNodeType_value = map[string]int32{
 	"PrerequisiteNodeType_":         0,
 	"PrerequisiteMessage_":          1,
 	"PrerequisiteLogic_":            2,
 	"PrerequisiteAfterObservation_": 3,
+	"PrerequisiteSequence_":         4,
+	"PrerequisiteProfile_":          5,
 }
 
 func (x PrerequisiteNodeType) String() string {
@@ -220,6 +249,36 @@ func (x PrerequisiteNodeType) String() string {
 }
 func (PrerequisiteNodeType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
+type AttributeOperator int32
+
+const (
+	AttributeOperator_AttributeOperator_ AttributeOperator = 0
+	AttributeOperator_AttrEQ             AttributeOperator = 1
+	AttributeOperator_AttrNE             AttributeOperator = 2
+	AttributeOperator_AttrIn             AttributeOperator = 3
+	AttributeOperator_AttrNotIn          AttributeOperator = 4
+)
+
+var AttributeOperator_name = map[int32]string{
+	0: "AttributeOperator_",
+	1: "AttrEQ",
+	2: "AttrNE",
+	3: "AttrIn",
+	4: "AttrNotIn",
+}
+var AttributeOperator_value = map[string]int32{
+	"AttributeOperator_": 0,
+	"AttrEQ":             1,
+	"AttrNE":             2,
+	"AttrIn":             3,
+	"AttrNotIn":          4,
+}
+
+func (x AttributeOperator) String() string {
+	return proto1.EnumName(AttributeOperator_name, int32(x))
+}
+func (AttributeOperator) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
 type ReactionType int32
 
 const (
@@ -231,9 +290,13 @@ const (
 	// traffic (e.g., load-balancing, traffic shaping)
 	ReactionType_ReactionTrafficBalance ReactionType = 101
 	ReactionType_ReactionTrafficRouting ReactionType = 102
+	// derived signals, submits a synthetic event inside OnSubmission
+	ReactionType_ReactionEmitEvent ReactionType = 201
 	// Notification & alert
 	ReactionType_ReactionPrintLog ReactionType = 1001
 	ReactionType_ReactionEmail    ReactionType = 1002
+	// registered by name, see reaction.RegisterCustom
+	ReactionType_ReactionCustom ReactionType = 9001
 )
 
 var ReactionType_name = map[int32]string{
@@ -243,8 +306,10 @@ var ReactionType_name = map[int32]string{
 	3:    "ReactionFaultDrop",
 	101:  "ReactionTrafficBalance",
 	102:  "ReactionTrafficRouting",
+	201:  "ReactionEmitEvent",
 	1001: "ReactionPrintLog",
 	1002: "ReactionEmail",
+	9001: "ReactionCustom",
 }
 var ReactionType_value = map[string]int32{
 	"ReactionType_":          0,
@@ -253,14 +318,74 @@ var ReactionType_value = map[string]int32{
 	"ReactionFaultDrop":      3,
 	"ReactionTrafficBalance": 101,
 	"ReactionTrafficRouting": 102,
+	"ReactionEmitEvent":      201,
 	"ReactionPrintLog":       1001,
 	"ReactionEmail":          1002,
+	"ReactionCustom":         9001,
 }
 
 func (x ReactionType) String() string {
 	return proto1.EnumName(ReactionType_name, int32(x))
 }
-func (ReactionType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (ReactionType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+type DelayDistribution int32
+
+const (
+	DelayDistribution_DelayDistribution_ DelayDistribution = 0
+	DelayDistribution_DelayUniform       DelayDistribution = 1
+	DelayDistribution_DelayNormal        DelayDistribution = 2
+	DelayDistribution_DelayExponential   DelayDistribution = 3
+	DelayDistribution_DelayPareto        DelayDistribution = 4
+)
+
+var DelayDistribution_name = map[int32]string{
+	0: "DelayDistribution_",
+	1: "DelayUniform",
+	2: "DelayNormal",
+	3: "DelayExponential",
+	4: "DelayPareto",
+}
+var DelayDistribution_value = map[string]int32{
+	"DelayDistribution_": 0,
+	"DelayUniform":       1,
+	"DelayNormal":        2,
+	"DelayExponential":   3,
+	"DelayPareto":        4,
+}
+
+func (x DelayDistribution) String() string {
+	return proto1.EnumName(DelayDistribution_name, int32(x))
+}
+func (DelayDistribution) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+// FiringMode decides fires by PrerequisiteSnapshot.acc, the accomplished state of the request
+type FiringMode int32
+
+const (
+	FiringMode_FiringLevel   FiringMode = 0
+	FiringMode_FiringOnce    FiringMode = 1
+	FiringMode_FiringLatched FiringMode = 2
+	FiringMode_FiringEdge    FiringMode = 3
+)
+
+var FiringMode_name = map[int32]string{
+	0: "FiringLevel",
+	1: "FiringOnce",
+	2: "FiringLatched",
+	3: "FiringEdge",
+}
+var FiringMode_value = map[string]int32{
+	"FiringLevel":   0,
+	"FiringOnce":    1,
+	"FiringLatched": 2,
+	"FiringEdge":    3,
+}
+
+func (x FiringMode) String() string {
+	return proto1.EnumName(FiringMode_name, int32(x))
+}
+func (FiringMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 type PathType int32
 
@@ -284,7 +409,7 @@ var PathType_value = map[string]int32{
 func (x PathType) String() string {
 	return proto1.EnumName(PathType_name, int32(x))
 }
-func (PathType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (PathType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 type LogOutType int32
 
@@ -311,7 +436,35 @@ var LogOutType_value = map[string]int32{
 func (x LogOutType) String() string {
 	return proto1.EnumName(LogOutType_name, int32(x))
 }
-func (LogOutType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (LogOutType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+// LogEncoderType is the format of log entries, see observation.Encoder
+type LogEncoderType int32
+
+const (
+	LogEncoderType_LogEncoderType_ LogEncoderType = 0
+	LogEncoderType_JSONLines       LogEncoderType = 1
+	LogEncoderType_Logfmt          LogEncoderType = 2
+	LogEncoderType_Console         LogEncoderType = 3
+)
+
+var LogEncoderType_name = map[int32]string{
+	0: "LogEncoderType_",
+	1: "JSONLines",
+	2: "Logfmt",
+	3: "Console",
+}
+var LogEncoderType_value = map[string]int32{
+	"LogEncoderType_": 0,
+	"JSONLines":       1,
+	"Logfmt":          2,
+	"Console":         3,
+}
+
+func (x LogEncoderType) String() string {
+	return proto1.EnumName(LogEncoderType_name, int32(x))
+}
+func (LogEncoderType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 type MetricType int32
 
@@ -341,7 +494,7 @@ var MetricType_value = map[string]int32{
 func (x MetricType) String() string {
 	return proto1.EnumName(MetricType_name, int32(x))
 }
-func (MetricType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (MetricType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 type ObservationType int32
 
@@ -371,7 +524,7 @@ var ObservationType_value = map[string]int32{
 func (x ObservationType) String() string {
 	return proto1.EnumName(ObservationType_name, int32(x))
 }
-func (ObservationType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (ObservationType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 type LanguageType int32
 
@@ -398,7 +551,7 @@ var LanguageType_value = map[string]int32{
 func (x LanguageType) String() string {
 	return proto1.EnumName(LanguageType_name, int32(x))
 }
-func (LanguageType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (LanguageType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 type AttributeValueType int32
 
@@ -422,7 +575,7 @@ var AttributeValueType_value = map[string]int32{
 func (x AttributeValueType) String() string {
 	return proto1.EnumName(AttributeValueType_name, int32(x))
 }
-func (AttributeValueType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (AttributeValueType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 type EventRecorderType int32
 
@@ -449,7 +602,38 @@ var EventRecorderType_value = map[string]int32{
 func (x EventRecorderType) String() string {
 	return proto1.EnumName(EventRecorderType_name, int32(x))
 }
-func (EventRecorderType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (EventRecorderType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+// Severity is the level of log entries, SeverityInfo if not set
+type Severity int32
+
+const (
+	Severity_Severity_     Severity = 0
+	Severity_SeverityDebug Severity = 1
+	Severity_SeverityInfo  Severity = 2
+	Severity_SeverityWarn  Severity = 3
+	Severity_SeverityError Severity = 4
+)
+
+var Severity_name = map[int32]string{
+	0: "Severity_",
+	1: "SeverityDebug",
+	2: "SeverityInfo",
+	3: "SeverityWarn",
+	4: "SeverityError",
+}
+var Severity_value = map[string]int32{
+	"Severity_":     0,
+	"SeverityDebug": 1,
+	"SeverityInfo":  2,
+	"SeverityWarn":  3,
+	"SeverityError": 4,
+}
+
+func (x Severity) String() string {
+	return proto1.EnumName(Severity_name, int32(x))
+}
+func (Severity) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 // ******************* from 3mb WIP
 type MessageType int32
@@ -474,7 +658,7 @@ var MessageType_value = map[string]int32{
 func (x MessageType) String() string {
 	return proto1.EnumName(MessageType_name, int32(x))
 }
-func (MessageType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (MessageType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 type ActionType int32
 
@@ -498,12 +682,13 @@ var ActionType_value = map[string]int32{
 func (x ActionType) String() string {
 	return proto1.EnumName(ActionType_name, int32(x))
 }
-func (ActionType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (ActionType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 type ConditionMessage struct {
-	Type  ConditionType     `protobuf:"varint,1,opt,name=type,enum=context_bus.ConditionType" json:"type,omitempty"`
-	Op    ConditionOperator `protobuf:"varint,2,opt,name=op,enum=context_bus.ConditionOperator" json:"op,omitempty"`
-	Value int64             `protobuf:"varint,3,opt,name=value" json:"value,omitempty"`
+	Type   ConditionType     `protobuf:"varint,1,opt,name=type,enum=context_bus.ConditionType" json:"type,omitempty"`
+	Op     ConditionOperator `protobuf:"varint,2,opt,name=op,enum=context_bus.ConditionOperator" json:"op,omitempty"`
+	Value  int64             `protobuf:"varint,3,opt,name=value" json:"value,omitempty"`
+	Window int64             `protobuf:"varint,4,opt,name=window" json:"window,omitempty"`
 }
 
 func (m *ConditionMessage) Reset()                    { *m = ConditionMessage{} }
@@ -532,8 +717,16 @@ func (m *ConditionMessage) GetValue() int64 {
 	return 0
 }
 
+func (m *ConditionMessage) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
 type ConditionLogic struct {
 	Type   LogicType `protobuf:"varint,1,opt,name=type,enum=context_bus.LogicType" json:"type,omitempty"`
+	K      int64     `protobuf:"varint,2,opt,name=k" json:"k,omitempty"`
 	Parent int64     `protobuf:"varint,100,opt,name=parent" json:"parent,omitempty"`
 	List   []int64   `protobuf:"varint,101,rep,packed,name=list" json:"list,omitempty"`
 }
@@ -550,6 +743,13 @@ func (m *ConditionLogic) GetType() LogicType {
 	return LogicType_LogicType_
 }
 
+func (m *ConditionLogic) GetK() int64 {
+	if m != nil {
+		return m.K
+	}
+	return 0
+}
+
 func (m *ConditionLogic) GetParent() int64 {
 	if m != nil {
 		return m.Parent
@@ -620,16 +820,50 @@ func (m *ConditionTree) GetLeafIDs() []int64 {
 	return nil
 }
 
+// AttributeCondition compares the value of the event addressed by path, see EventWhat.GetValue
+type AttributeCondition struct {
+	Path   *Path             `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	Op     AttributeOperator `protobuf:"varint,2,opt,name=op,enum=context_bus.AttributeOperator" json:"op,omitempty"`
+	Values []string          `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *AttributeCondition) Reset()                    { *m = AttributeCondition{} }
+func (m *AttributeCondition) String() string            { return proto1.CompactTextString(m) }
+func (*AttributeCondition) ProtoMessage()               {}
+func (*AttributeCondition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *AttributeCondition) GetPath() *Path {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *AttributeCondition) GetOp() AttributeOperator {
+	if m != nil {
+		return m.Op
+	}
+	return AttributeOperator_AttributeOperator_
+}
+
+func (m *AttributeCondition) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 type PrerequisiteMessage struct {
-	Name     string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	CondTree *ConditionTree `protobuf:"bytes,2,opt,name=cond_tree,json=condTree" json:"cond_tree,omitempty"`
-	Parent   int64          `protobuf:"varint,100,opt,name=parent" json:"parent,omitempty"`
+	Name     string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	CondTree *ConditionTree        `protobuf:"bytes,2,opt,name=cond_tree,json=condTree" json:"cond_tree,omitempty"`
+	Attrs    []*AttributeCondition `protobuf:"bytes,3,rep,name=attrs" json:"attrs,omitempty"`
+	Parent   int64                 `protobuf:"varint,100,opt,name=parent" json:"parent,omitempty"`
 }
 
 func (m *PrerequisiteMessage) Reset()                    { *m = PrerequisiteMessage{} }
 func (m *PrerequisiteMessage) String() string            { return proto1.CompactTextString(m) }
 func (*PrerequisiteMessage) ProtoMessage()               {}
-func (*PrerequisiteMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*PrerequisiteMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *PrerequisiteMessage) GetName() string {
 	if m != nil {
@@ -645,6 +879,13 @@ func (m *PrerequisiteMessage) GetCondTree() *ConditionTree {
 	return nil
 }
 
+func (m *PrerequisiteMessage) GetAttrs() []*AttributeCondition {
+	if m != nil {
+		return m.Attrs
+	}
+	return nil
+}
+
 func (m *PrerequisiteMessage) GetParent() int64 {
 	if m != nil {
 		return m.Parent
@@ -652,15 +893,18 @@ func (m *PrerequisiteMessage) GetParent() int64 {
 	return 0
 }
 
+// PrerequisiteEvent is evaluated after observation against the event pair (name, current event)
 type PrerequisiteEvent struct {
-	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Latency int64  `protobuf:"varint,2,opt,name=latency" json:"latency,omitempty"`
+	Name     string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Latency  int64          `protobuf:"varint,2,opt,name=latency" json:"latency,omitempty"`
+	CondTree *ConditionTree `protobuf:"bytes,3,opt,name=cond_tree,json=condTree" json:"cond_tree,omitempty"`
+	Parent   int64          `protobuf:"varint,100,opt,name=parent" json:"parent,omitempty"`
 }
 
 func (m *PrerequisiteEvent) Reset()                    { *m = PrerequisiteEvent{} }
 func (m *PrerequisiteEvent) String() string            { return proto1.CompactTextString(m) }
 func (*PrerequisiteEvent) ProtoMessage()               {}
-func (*PrerequisiteEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*PrerequisiteEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *PrerequisiteEvent) GetName() string {
 	if m != nil {
@@ -676,8 +920,97 @@ func (m *PrerequisiteEvent) GetLatency() int64 {
 	return 0
 }
 
+func (m *PrerequisiteEvent) GetCondTree() *ConditionTree {
+	if m != nil {
+		return m.CondTree
+	}
+	return nil
+}
+
+func (m *PrerequisiteEvent) GetParent() int64 {
+	if m != nil {
+		return m.Parent
+	}
+	return 0
+}
+
+// PrerequisiteSequence is accomplished once names are observed in order, e.g., EventA -> EventB
+type PrerequisiteSequence struct {
+	Names  []string `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
+	MaxGap int64    `protobuf:"varint,2,opt,name=max_gap,json=maxGap" json:"max_gap,omitempty"`
+	Parent int64    `protobuf:"varint,100,opt,name=parent" json:"parent,omitempty"`
+}
+
+func (m *PrerequisiteSequence) Reset()                    { *m = PrerequisiteSequence{} }
+func (m *PrerequisiteSequence) String() string            { return proto1.CompactTextString(m) }
+func (*PrerequisiteSequence) ProtoMessage()               {}
+func (*PrerequisiteSequence) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *PrerequisiteSequence) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *PrerequisiteSequence) GetMaxGap() int64 {
+	if m != nil {
+		return m.MaxGap
+	}
+	return 0
+}
+
+func (m *PrerequisiteSequence) GetParent() int64 {
+	if m != nil {
+		return m.Parent
+	}
+	return 0
+}
+
+// PrerequisiteProfile compares a value of the latest EnvironmentalProfile, e.g., hardware.cpu.percent > 80
+type PrerequisiteProfile struct {
+	Path   string            `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	Op     ConditionOperator `protobuf:"varint,2,opt,name=op,enum=context_bus.ConditionOperator" json:"op,omitempty"`
+	Value  float64           `protobuf:"fixed64,3,opt,name=value" json:"value,omitempty"`
+	Parent int64             `protobuf:"varint,100,opt,name=parent" json:"parent,omitempty"`
+}
+
+func (m *PrerequisiteProfile) Reset()                    { *m = PrerequisiteProfile{} }
+func (m *PrerequisiteProfile) String() string            { return proto1.CompactTextString(m) }
+func (*PrerequisiteProfile) ProtoMessage()               {}
+func (*PrerequisiteProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *PrerequisiteProfile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PrerequisiteProfile) GetOp() ConditionOperator {
+	if m != nil {
+		return m.Op
+	}
+	return ConditionOperator_ConditionOperator_
+}
+
+func (m *PrerequisiteProfile) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *PrerequisiteProfile) GetParent() int64 {
+	if m != nil {
+		return m.Parent
+	}
+	return 0
+}
+
 type PrerequisiteLogic struct {
 	Type   LogicType `protobuf:"varint,1,opt,name=type,enum=context_bus.LogicType" json:"type,omitempty"`
+	K      int64     `protobuf:"varint,2,opt,name=k" json:"k,omitempty"`
 	Parent int64     `protobuf:"varint,100,opt,name=parent" json:"parent,omitempty"`
 	List   []int64   `protobuf:"varint,101,rep,packed,name=list" json:"list,omitempty"`
 }
@@ -685,7 +1018,7 @@ type PrerequisiteLogic struct {
 func (m *PrerequisiteLogic) Reset()                    { *m = PrerequisiteLogic{} }
 func (m *PrerequisiteLogic) String() string            { return proto1.CompactTextString(m) }
 func (*PrerequisiteLogic) ProtoMessage()               {}
-func (*PrerequisiteLogic) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*PrerequisiteLogic) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *PrerequisiteLogic) GetType() LogicType {
 	if m != nil {
@@ -694,6 +1027,13 @@ func (m *PrerequisiteLogic) GetType() LogicType {
 	return LogicType_LogicType_
 }
 
+func (m *PrerequisiteLogic) GetK() int64 {
+	if m != nil {
+		return m.K
+	}
+	return 0
+}
+
 func (m *PrerequisiteLogic) GetParent() int64 {
 	if m != nil {
 		return m.Parent
@@ -709,17 +1049,19 @@ func (m *PrerequisiteLogic) GetList() []int64 {
 }
 
 type PrerequisiteNode struct {
-	Id        int64                `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Type      PrerequisiteNodeType `protobuf:"varint,2,opt,name=type,enum=context_bus.PrerequisiteNodeType" json:"type,omitempty"`
-	Message   *PrerequisiteMessage `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
-	PrevEvent *PrerequisiteEvent   `protobuf:"bytes,4,opt,name=prevEvent" json:"prevEvent,omitempty"`
-	Logic     *PrerequisiteLogic   `protobuf:"bytes,5,opt,name=logic" json:"logic,omitempty"`
+	Id        int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Type      PrerequisiteNodeType  `protobuf:"varint,2,opt,name=type,enum=context_bus.PrerequisiteNodeType" json:"type,omitempty"`
+	Message   *PrerequisiteMessage  `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+	PrevEvent *PrerequisiteEvent    `protobuf:"bytes,4,opt,name=prevEvent" json:"prevEvent,omitempty"`
+	Logic     *PrerequisiteLogic    `protobuf:"bytes,5,opt,name=logic" json:"logic,omitempty"`
+	Sequence  *PrerequisiteSequence `protobuf:"bytes,6,opt,name=sequence" json:"sequence,omitempty"`
+	Profile   *PrerequisiteProfile  `protobuf:"bytes,7,opt,name=profile" json:"profile,omitempty"`
 }
 
 func (m *PrerequisiteNode) Reset()                    { *m = PrerequisiteNode{} }
 func (m *PrerequisiteNode) String() string            { return proto1.CompactTextString(m) }
 func (*PrerequisiteNode) ProtoMessage()               {}
-func (*PrerequisiteNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*PrerequisiteNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *PrerequisiteNode) GetId() int64 {
 	if m != nil {
@@ -756,6 +1098,20 @@ func (m *PrerequisiteNode) GetLogic() *PrerequisiteLogic {
 	return nil
 }
 
+func (m *PrerequisiteNode) GetSequence() *PrerequisiteSequence {
+	if m != nil {
+		return m.Sequence
+	}
+	return nil
+}
+
+func (m *PrerequisiteNode) GetProfile() *PrerequisiteProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
 // prerequisite tree
 type PrerequisiteTree struct {
 	Nodes   []*PrerequisiteNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
@@ -765,7 +1121,7 @@ type PrerequisiteTree struct {
 func (m *PrerequisiteTree) Reset()                    { *m = PrerequisiteTree{} }
 func (m *PrerequisiteTree) String() string            { return proto1.CompactTextString(m) }
 func (*PrerequisiteTree) ProtoMessage()               {}
-func (*PrerequisiteTree) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*PrerequisiteTree) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *PrerequisiteTree) GetNodes() []*PrerequisiteNode {
 	if m != nil {
@@ -784,12 +1140,16 @@ func (m *PrerequisiteTree) GetLeafIDs() []int64 {
 type PrerequisiteSnapshot struct {
 	Value []int64 `protobuf:"varint,1,rep,packed,name=value" json:"value,omitempty"`
 	Acc   bool    `protobuf:"varint,2,opt,name=acc" json:"acc,omitempty"`
+	// for PrerequisiteSequence_ nodes only
+	Clock    int64   `protobuf:"varint,3,opt,name=clock" json:"clock,omitempty"`
+	Order    []int64 `protobuf:"varint,4,rep,packed,name=order" json:"order,omitempty"`
+	Sequence []bool  `protobuf:"varint,5,rep,packed,name=sequence" json:"sequence,omitempty"`
 }
 
 func (m *PrerequisiteSnapshot) Reset()                    { *m = PrerequisiteSnapshot{} }
 func (m *PrerequisiteSnapshot) String() string            { return proto1.CompactTextString(m) }
 func (*PrerequisiteSnapshot) ProtoMessage()               {}
-func (*PrerequisiteSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*PrerequisiteSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *PrerequisiteSnapshot) GetValue() []int64 {
 	if m != nil {
@@ -805,6 +1165,27 @@ func (m *PrerequisiteSnapshot) GetAcc() bool {
 	return false
 }
 
+func (m *PrerequisiteSnapshot) GetClock() int64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *PrerequisiteSnapshot) GetOrder() []int64 {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (m *PrerequisiteSnapshot) GetSequence() []bool {
+	if m != nil {
+		return m.Sequence
+	}
+	return nil
+}
+
 type PrerequisiteSnapshots struct {
 	Snapshots map[string]*PrerequisiteSnapshot `protobuf:"bytes,1,rep,name=snapshots" json:"snapshots,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -812,7 +1193,7 @@ type PrerequisiteSnapshots struct {
 func (m *PrerequisiteSnapshots) Reset()                    { *m = PrerequisiteSnapshots{} }
 func (m *PrerequisiteSnapshots) String() string            { return proto1.CompactTextString(m) }
 func (*PrerequisiteSnapshots) ProtoMessage()               {}
-func (*PrerequisiteSnapshots) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*PrerequisiteSnapshots) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *PrerequisiteSnapshots) GetSnapshots() map[string]*PrerequisiteSnapshot {
 	if m != nil {
@@ -822,13 +1203,19 @@ func (m *PrerequisiteSnapshots) GetSnapshots() map[string]*PrerequisiteSnapshot
 }
 
 type FaultDelayParam struct {
-	Ms int64 `protobuf:"varint,1,opt,name=ms" json:"ms,omitempty"`
+	Ms           int64             `protobuf:"varint,1,opt,name=ms" json:"ms,omitempty"`
+	Distribution DelayDistribution `protobuf:"varint,2,opt,name=distribution,enum=context_bus.DelayDistribution" json:"distribution,omitempty"`
+	Min          int64             `protobuf:"varint,3,opt,name=min" json:"min,omitempty"`
+	Max          int64             `protobuf:"varint,4,opt,name=max" json:"max,omitempty"`
+	Stddev       int64             `protobuf:"varint,5,opt,name=stddev" json:"stddev,omitempty"`
+	Shape        float64           `protobuf:"fixed64,6,opt,name=shape" json:"shape,omitempty"`
+	Seed         int64             `protobuf:"varint,7,opt,name=seed" json:"seed,omitempty"`
 }
 
 func (m *FaultDelayParam) Reset()                    { *m = FaultDelayParam{} }
 func (m *FaultDelayParam) String() string            { return proto1.CompactTextString(m) }
 func (*FaultDelayParam) ProtoMessage()               {}
-func (*FaultDelayParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*FaultDelayParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *FaultDelayParam) GetMs() int64 {
 	if m != nil {
@@ -837,13 +1224,55 @@ func (m *FaultDelayParam) GetMs() int64 {
 	return 0
 }
 
+func (m *FaultDelayParam) GetDistribution() DelayDistribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return DelayDistribution_DelayDistribution_
+}
+
+func (m *FaultDelayParam) GetMin() int64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *FaultDelayParam) GetMax() int64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *FaultDelayParam) GetStddev() int64 {
+	if m != nil {
+		return m.Stddev
+	}
+	return 0
+}
+
+func (m *FaultDelayParam) GetShape() float64 {
+	if m != nil {
+		return m.Shape
+	}
+	return 0
+}
+
+func (m *FaultDelayParam) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
 type TrafficBalanceParam struct {
 }
 
 func (m *TrafficBalanceParam) Reset()                    { *m = TrafficBalanceParam{} }
 func (m *TrafficBalanceParam) String() string            { return proto1.CompactTextString(m) }
 func (*TrafficBalanceParam) ProtoMessage()               {}
-func (*TrafficBalanceParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*TrafficBalanceParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 type TrafficRoutingParam struct {
 }
@@ -851,7 +1280,236 @@ type TrafficRoutingParam struct {
 func (m *TrafficRoutingParam) Reset()                    { *m = TrafficRoutingParam{} }
 func (m *TrafficRoutingParam) String() string            { return proto1.CompactTextString(m) }
 func (*TrafficRoutingParam) ProtoMessage()               {}
-func (*TrafficRoutingParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*TrafficRoutingParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+// SMTPParam sends notifications as plain-text emails
+type SMTPParam struct {
+	Addr     string   `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	From     string   `protobuf:"bytes,2,opt,name=from" json:"from,omitempty"`
+	To       []string `protobuf:"bytes,3,rep,name=to" json:"to,omitempty"`
+	Username string   `protobuf:"bytes,4,opt,name=username" json:"username,omitempty"`
+	Password string   `protobuf:"bytes,5,opt,name=password" json:"password,omitempty"`
+}
+
+func (m *SMTPParam) Reset()                    { *m = SMTPParam{} }
+func (m *SMTPParam) String() string            { return proto1.CompactTextString(m) }
+func (*SMTPParam) ProtoMessage()               {}
+func (*SMTPParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *SMTPParam) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *SMTPParam) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *SMTPParam) GetTo() []string {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *SMTPParam) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SMTPParam) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+// WebhookParam posts notifications as JSON, see reaction.Notification
+type WebhookParam struct {
+	Url     string            `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *WebhookParam) Reset()                    { *m = WebhookParam{} }
+func (m *WebhookParam) String() string            { return proto1.CompactTextString(m) }
+func (*WebhookParam) ProtoMessage()               {}
+func (*WebhookParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *WebhookParam) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhookParam) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+// EmailParam renders notifications from the triggering EventData with text/template, see reaction.NotificationData,
+// and sends them via either smtp or webhook.
+type EmailParam struct {
+	Smtp           *SMTPParam    `protobuf:"bytes,1,opt,name=smtp" json:"smtp,omitempty"`
+	Webhook        *WebhookParam `protobuf:"bytes,2,opt,name=webhook" json:"webhook,omitempty"`
+	Subject        string        `protobuf:"bytes,3,opt,name=subject" json:"subject,omitempty"`
+	Body           string        `protobuf:"bytes,4,opt,name=body" json:"body,omitempty"`
+	DedupeWindow   int64         `protobuf:"varint,5,opt,name=dedupe_window,json=dedupeWindow" json:"dedupe_window,omitempty"`
+	CoalesceWindow int64         `protobuf:"varint,6,opt,name=coalesce_window,json=coalesceWindow" json:"coalesce_window,omitempty"`
+}
+
+func (m *EmailParam) Reset()                    { *m = EmailParam{} }
+func (m *EmailParam) String() string            { return proto1.CompactTextString(m) }
+func (*EmailParam) ProtoMessage()               {}
+func (*EmailParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *EmailParam) GetSmtp() *SMTPParam {
+	if m != nil {
+		return m.Smtp
+	}
+	return nil
+}
+
+func (m *EmailParam) GetWebhook() *WebhookParam {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+func (m *EmailParam) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *EmailParam) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *EmailParam) GetDedupeWindow() int64 {
+	if m != nil {
+		return m.DedupeWindow
+	}
+	return 0
+}
+
+func (m *EmailParam) GetCoalesceWindow() int64 {
+	if m != nil {
+		return m.CoalesceWindow
+	}
+	return 0
+}
+
+type EmitEventParam struct {
+	Name    string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Message string  `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Paths   []*Path `protobuf:"bytes,3,rep,name=paths" json:"paths,omitempty"`
+}
+
+func (m *EmitEventParam) Reset()                    { *m = EmitEventParam{} }
+func (m *EmitEventParam) String() string            { return proto1.CompactTextString(m) }
+func (*EmitEventParam) ProtoMessage()               {}
+func (*EmitEventParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *EmitEventParam) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EmitEventParam) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *EmitEventParam) GetPaths() []*Path {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+type CustomParam struct {
+	Name   string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Params []byte `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *CustomParam) Reset()                    { *m = CustomParam{} }
+func (m *CustomParam) String() string            { return proto1.CompactTextString(m) }
+func (*CustomParam) ProtoMessage()               {}
+func (*CustomParam) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *CustomParam) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CustomParam) GetParams() []byte {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// FiringPolicy limits fires of a reaction once its prerequisites are accomplished, e.g., for chaos experiments
+type FiringPolicy struct {
+	Probability float64 `protobuf:"fixed64,1,opt,name=probability" json:"probability,omitempty"`
+	MaxFires    int64   `protobuf:"varint,2,opt,name=max_fires,json=maxFires" json:"max_fires,omitempty"`
+	Interval    int64   `protobuf:"varint,3,opt,name=interval" json:"interval,omitempty"`
+	TotalFires  int64   `protobuf:"varint,4,opt,name=total_fires,json=totalFires" json:"total_fires,omitempty"`
+}
+
+func (m *FiringPolicy) Reset()                    { *m = FiringPolicy{} }
+func (m *FiringPolicy) String() string            { return proto1.CompactTextString(m) }
+func (*FiringPolicy) ProtoMessage()               {}
+func (*FiringPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *FiringPolicy) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+func (m *FiringPolicy) GetMaxFires() int64 {
+	if m != nil {
+		return m.MaxFires
+	}
+	return 0
+}
+
+func (m *FiringPolicy) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *FiringPolicy) GetTotalFires() int64 {
+	if m != nil {
+		return m.TotalFires
+	}
+	return 0
+}
 
 type ReactionConfigure struct {
 	Type ReactionType `protobuf:"varint,1,opt,name=type,enum=context_bus.ReactionType" json:"type,omitempty"`
@@ -859,14 +1517,20 @@ type ReactionConfigure struct {
 	//	*ReactionConfigure_FaultDelay
 	//	*ReactionConfigure_TrafficBalance
 	//	*ReactionConfigure_TrafficRouting
+	//	*ReactionConfigure_EmitEvent
+	//	*ReactionConfigure_Email
+	//	*ReactionConfigure_Custom
 	Params  isReactionConfigure_Params `protobuf_oneof:"params"`
 	PreTree *PrerequisiteTree          `protobuf:"bytes,3,opt,name=pre_tree,json=preTree" json:"pre_tree,omitempty"`
+	Policy  *FiringPolicy              `protobuf:"bytes,4,opt,name=policy" json:"policy,omitempty"`
+	DryRun  bool                       `protobuf:"varint,5,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+	Mode    FiringMode                 `protobuf:"varint,6,opt,name=mode,enum=context_bus.FiringMode" json:"mode,omitempty"`
 }
 
 func (m *ReactionConfigure) Reset()                    { *m = ReactionConfigure{} }
 func (m *ReactionConfigure) String() string            { return proto1.CompactTextString(m) }
 func (*ReactionConfigure) ProtoMessage()               {}
-func (*ReactionConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*ReactionConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 type isReactionConfigure_Params interface{ isReactionConfigure_Params() }
 
@@ -879,10 +1543,22 @@ type ReactionConfigure_TrafficBalance struct {
 type ReactionConfigure_TrafficRouting struct {
 	TrafficRouting *TrafficRoutingParam `protobuf:"bytes,2102,opt,name=TrafficRouting,oneof"`
 }
+type ReactionConfigure_EmitEvent struct {
+	EmitEvent *EmitEventParam `protobuf:"bytes,2201,opt,name=EmitEvent,oneof"`
+}
+type ReactionConfigure_Email struct {
+	Email *EmailParam `protobuf:"bytes,3002,opt,name=Email,oneof"`
+}
+type ReactionConfigure_Custom struct {
+	Custom *CustomParam `protobuf:"bytes,2901,opt,name=Custom,oneof"`
+}
 
 func (*ReactionConfigure_FaultDelay) isReactionConfigure_Params()     {}
 func (*ReactionConfigure_TrafficBalance) isReactionConfigure_Params() {}
 func (*ReactionConfigure_TrafficRouting) isReactionConfigure_Params() {}
+func (*ReactionConfigure_EmitEvent) isReactionConfigure_Params()      {}
+func (*ReactionConfigure_Email) isReactionConfigure_Params()          {}
+func (*ReactionConfigure_Custom) isReactionConfigure_Params()         {}
 
 func (m *ReactionConfigure) GetParams() isReactionConfigure_Params {
 	if m != nil {
@@ -919,6 +1595,27 @@ func (m *ReactionConfigure) GetTrafficRouting() *TrafficRoutingParam {
 	return nil
 }
 
+func (m *ReactionConfigure) GetEmitEvent() *EmitEventParam {
+	if x, ok := m.GetParams().(*ReactionConfigure_EmitEvent); ok {
+		return x.EmitEvent
+	}
+	return nil
+}
+
+func (m *ReactionConfigure) GetEmail() *EmailParam {
+	if x, ok := m.GetParams().(*ReactionConfigure_Email); ok {
+		return x.Email
+	}
+	return nil
+}
+
+func (m *ReactionConfigure) GetCustom() *CustomParam {
+	if x, ok := m.GetParams().(*ReactionConfigure_Custom); ok {
+		return x.Custom
+	}
+	return nil
+}
+
 func (m *ReactionConfigure) GetPreTree() *PrerequisiteTree {
 	if m != nil {
 		return m.PreTree
@@ -926,12 +1623,36 @@ func (m *ReactionConfigure) GetPreTree() *PrerequisiteTree {
 	return nil
 }
 
+func (m *ReactionConfigure) GetPolicy() *FiringPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (m *ReactionConfigure) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ReactionConfigure) GetMode() FiringMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FiringMode_FiringLevel
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ReactionConfigure) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
 	return _ReactionConfigure_OneofMarshaler, _ReactionConfigure_OneofUnmarshaler, _ReactionConfigure_OneofSizer, []interface{}{
 		(*ReactionConfigure_FaultDelay)(nil),
 		(*ReactionConfigure_TrafficBalance)(nil),
 		(*ReactionConfigure_TrafficRouting)(nil),
+		(*ReactionConfigure_EmitEvent)(nil),
+		(*ReactionConfigure_Email)(nil),
+		(*ReactionConfigure_Custom)(nil),
 	}
 }
 
@@ -954,6 +1675,21 @@ func _ReactionConfigure_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) err
 		if err := b.EncodeMessage(x.TrafficRouting); err != nil {
 			return err
 		}
+	case *ReactionConfigure_EmitEvent:
+		b.EncodeVarint(2201<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.EmitEvent); err != nil {
+			return err
+		}
+	case *ReactionConfigure_Email:
+		b.EncodeVarint(3002<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.Email); err != nil {
+			return err
+		}
+	case *ReactionConfigure_Custom:
+		b.EncodeVarint(2901<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.Custom); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ReactionConfigure.Params has unexpected type %T", x)
@@ -988,6 +1724,30 @@ func _ReactionConfigure_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *p
 		err := b.DecodeMessage(msg)
 		m.Params = &ReactionConfigure_TrafficRouting{msg}
 		return true, err
+	case 2201: // params.EmitEvent
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(EmitEventParam)
+		err := b.DecodeMessage(msg)
+		m.Params = &ReactionConfigure_EmitEvent{msg}
+		return true, err
+	case 3002: // params.Email
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(EmailParam)
+		err := b.DecodeMessage(msg)
+		m.Params = &ReactionConfigure_Email{msg}
+		return true, err
+	case 2901: // params.Custom
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(CustomParam)
+		err := b.DecodeMessage(msg)
+		m.Params = &ReactionConfigure_Custom{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1012,6 +1772,21 @@ func _ReactionConfigure_OneofSizer(msg proto1.Message) (n int) {
 		n += proto1.SizeVarint(2102<<3 | proto1.WireBytes)
 		n += proto1.SizeVarint(uint64(s))
 		n += s
+	case *ReactionConfigure_EmitEvent:
+		s := proto1.Size(x.EmitEvent)
+		n += proto1.SizeVarint(2201<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *ReactionConfigure_Email:
+		s := proto1.Size(x.Email)
+		n += proto1.SizeVarint(3002<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *ReactionConfigure_Custom:
+		s := proto1.Size(x.Custom)
+		n += proto1.SizeVarint(2901<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1027,7 +1802,7 @@ type Path struct {
 func (m *Path) Reset()                    { *m = Path{} }
 func (m *Path) String() string            { return proto1.CompactTextString(m) }
 func (*Path) ProtoMessage()               {}
-func (*Path) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*Path) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *Path) GetType() PathType {
 	if m != nil {
@@ -1051,7 +1826,7 @@ type AttributeConfigure struct {
 func (m *AttributeConfigure) Reset()                    { *m = AttributeConfigure{} }
 func (m *AttributeConfigure) String() string            { return proto1.CompactTextString(m) }
 func (*AttributeConfigure) ProtoMessage()               {}
-func (*AttributeConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*AttributeConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *AttributeConfigure) GetName() string {
 	if m != nil {
@@ -1074,7 +1849,7 @@ type TimestampConfigure struct {
 func (m *TimestampConfigure) Reset()                    { *m = TimestampConfigure{} }
 func (m *TimestampConfigure) String() string            { return proto1.CompactTextString(m) }
 func (*TimestampConfigure) ProtoMessage()               {}
-func (*TimestampConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*TimestampConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *TimestampConfigure) GetFormat() string {
 	if m != nil {
@@ -1090,7 +1865,7 @@ type StackTraceConfigure struct {
 func (m *StackTraceConfigure) Reset()                    { *m = StackTraceConfigure{} }
 func (m *StackTraceConfigure) String() string            { return proto1.CompactTextString(m) }
 func (*StackTraceConfigure) ProtoMessage()               {}
-func (*StackTraceConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*StackTraceConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *StackTraceConfigure) GetSwitch() bool {
 	if m != nil {
@@ -1099,17 +1874,80 @@ func (m *StackTraceConfigure) GetSwitch() bool {
 	return false
 }
 
+// FileConfigure configures the file of LogOutType File, loggings of the same path share the file.
+// The file is rotated to "path.<time>" when it exceeds max_size or gets older than max_age,
+// only the latest max_backups rotated files are kept.
+type FileConfigure struct {
+	Path          string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	MaxSize       int64  `protobuf:"varint,2,opt,name=max_size,json=maxSize" json:"max_size,omitempty"`
+	MaxAge        int64  `protobuf:"varint,3,opt,name=max_age,json=maxAge" json:"max_age,omitempty"`
+	MaxBackups    int64  `protobuf:"varint,4,opt,name=max_backups,json=maxBackups" json:"max_backups,omitempty"`
+	Compress      bool   `protobuf:"varint,5,opt,name=compress" json:"compress,omitempty"`
+	FlushInterval int64  `protobuf:"varint,6,opt,name=flush_interval,json=flushInterval" json:"flush_interval,omitempty"`
+}
+
+func (m *FileConfigure) Reset()                    { *m = FileConfigure{} }
+func (m *FileConfigure) String() string            { return proto1.CompactTextString(m) }
+func (*FileConfigure) ProtoMessage()               {}
+func (*FileConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *FileConfigure) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FileConfigure) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *FileConfigure) GetMaxAge() int64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *FileConfigure) GetMaxBackups() int64 {
+	if m != nil {
+		return m.MaxBackups
+	}
+	return 0
+}
+
+func (m *FileConfigure) GetCompress() bool {
+	if m != nil {
+		return m.Compress
+	}
+	return false
+}
+
+func (m *FileConfigure) GetFlushInterval() int64 {
+	if m != nil {
+		return m.FlushInterval
+	}
+	return 0
+}
+
 type LoggingConfigure struct {
 	Timestamp  *TimestampConfigure   `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
 	Stacktrace *StackTraceConfigure  `protobuf:"bytes,2,opt,name=stacktrace" json:"stacktrace,omitempty"`
 	Attrs      []*AttributeConfigure `protobuf:"bytes,3,rep,name=attrs" json:"attrs,omitempty"`
 	Out        LogOutType            `protobuf:"varint,4,opt,name=out,enum=context_bus.LogOutType" json:"out,omitempty"`
+	File       *FileConfigure        `protobuf:"bytes,5,opt,name=file" json:"file,omitempty"`
+	Encoder    LogEncoderType        `protobuf:"varint,6,opt,name=encoder,enum=context_bus.LogEncoderType" json:"encoder,omitempty"`
+	Level      Severity              `protobuf:"varint,7,opt,name=level,enum=context_bus.Severity" json:"level,omitempty"`
+	MinLevel   Severity              `protobuf:"varint,8,opt,name=min_level,json=minLevel,enum=context_bus.Severity" json:"min_level,omitempty"`
 }
 
 func (m *LoggingConfigure) Reset()                    { *m = LoggingConfigure{} }
 func (m *LoggingConfigure) String() string            { return proto1.CompactTextString(m) }
 func (*LoggingConfigure) ProtoMessage()               {}
-func (*LoggingConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*LoggingConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *LoggingConfigure) GetTimestamp() *TimestampConfigure {
 	if m != nil {
@@ -1139,6 +1977,77 @@ func (m *LoggingConfigure) GetOut() LogOutType {
 	return LogOutType_LogOutType_
 }
 
+func (m *LoggingConfigure) GetFile() *FileConfigure {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *LoggingConfigure) GetEncoder() LogEncoderType {
+	if m != nil {
+		return m.Encoder
+	}
+	return LogEncoderType_LogEncoderType_
+}
+
+func (m *LoggingConfigure) GetLevel() Severity {
+	if m != nil {
+		return m.Level
+	}
+	return Severity_Severity_
+}
+
+func (m *LoggingConfigure) GetMinLevel() Severity {
+	if m != nil {
+		return m.MinLevel
+	}
+	return Severity_Severity_
+}
+
+// TailLoggingConfigure emits the event chain of a request (see ObservationType) in reversed order at the event carrying it,
+// only if the request turns out slow or errored, or a ReactionPrintLog of the event fires.
+// Events of the chain are buffered by the chain itself, they are expected to be configured without logging.
+type TailLoggingConfigure struct {
+	Logging *LoggingConfigure     `protobuf:"bytes,1,opt,name=logging" json:"logging,omitempty"`
+	Slow    int64                 `protobuf:"varint,2,opt,name=slow" json:"slow,omitempty"`
+	Errors  []*AttributeCondition `protobuf:"bytes,3,rep,name=errors" json:"errors,omitempty"`
+	Profile bool                  `protobuf:"varint,4,opt,name=profile" json:"profile,omitempty"`
+}
+
+func (m *TailLoggingConfigure) Reset()                    { *m = TailLoggingConfigure{} }
+func (m *TailLoggingConfigure) String() string            { return proto1.CompactTextString(m) }
+func (*TailLoggingConfigure) ProtoMessage()               {}
+func (*TailLoggingConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *TailLoggingConfigure) GetLogging() *LoggingConfigure {
+	if m != nil {
+		return m.Logging
+	}
+	return nil
+}
+
+func (m *TailLoggingConfigure) GetSlow() int64 {
+	if m != nil {
+		return m.Slow
+	}
+	return 0
+}
+
+func (m *TailLoggingConfigure) GetErrors() []*AttributeCondition {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *TailLoggingConfigure) GetProfile() bool {
+	if m != nil {
+		return m.Profile
+	}
+	return false
+}
+
 type TracingConfigure struct {
 	Start         bool                  `protobuf:"varint,1,opt,name=start" json:"start,omitempty"`
 	End           bool                  `protobuf:"varint,2,opt,name=end" json:"end,omitempty"`
@@ -1152,7 +2061,7 @@ type TracingConfigure struct {
 func (m *TracingConfigure) Reset()                    { *m = TracingConfigure{} }
 func (m *TracingConfigure) String() string            { return proto1.CompactTextString(m) }
 func (*TracingConfigure) ProtoMessage()               {}
-func (*TracingConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*TracingConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *TracingConfigure) GetStart() bool {
 	if m != nil {
@@ -1214,7 +2123,7 @@ type MetricsConfigure struct {
 func (m *MetricsConfigure) Reset()                    { *m = MetricsConfigure{} }
 func (m *MetricsConfigure) String() string            { return proto1.CompactTextString(m) }
 func (*MetricsConfigure) ProtoMessage()               {}
-func (*MetricsConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*MetricsConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *MetricsConfigure) GetType() MetricType {
 	if m != nil {
@@ -1252,16 +2161,17 @@ func (m *MetricsConfigure) GetAttrs() []*AttributeConfigure {
 }
 
 type ObservationConfigure struct {
-	Type    ObservationType     `protobuf:"varint,1,opt,name=type,enum=context_bus.ObservationType" json:"type,omitempty"`
-	Logging *LoggingConfigure   `protobuf:"bytes,2,opt,name=logging" json:"logging,omitempty"`
-	Tracing *TracingConfigure   `protobuf:"bytes,3,opt,name=tracing" json:"tracing,omitempty"`
-	Metrics []*MetricsConfigure `protobuf:"bytes,4,rep,name=metrics" json:"metrics,omitempty"`
+	Type    ObservationType       `protobuf:"varint,1,opt,name=type,enum=context_bus.ObservationType" json:"type,omitempty"`
+	Logging *LoggingConfigure     `protobuf:"bytes,2,opt,name=logging" json:"logging,omitempty"`
+	Tracing *TracingConfigure     `protobuf:"bytes,3,opt,name=tracing" json:"tracing,omitempty"`
+	Metrics []*MetricsConfigure   `protobuf:"bytes,4,rep,name=metrics" json:"metrics,omitempty"`
+	Tail    *TailLoggingConfigure `protobuf:"bytes,5,opt,name=tail" json:"tail,omitempty"`
 }
 
 func (m *ObservationConfigure) Reset()                    { *m = ObservationConfigure{} }
 func (m *ObservationConfigure) String() string            { return proto1.CompactTextString(m) }
 func (*ObservationConfigure) ProtoMessage()               {}
-func (*ObservationConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*ObservationConfigure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *ObservationConfigure) GetType() ObservationType {
 	if m != nil {
@@ -1291,15 +2201,23 @@ func (m *ObservationConfigure) GetMetrics() []*MetricsConfigure {
 	return nil
 }
 
+func (m *ObservationConfigure) GetTail() *TailLoggingConfigure {
+	if m != nil {
+		return m.Tail
+	}
+	return nil
+}
+
 type Configure struct {
 	Reactions    map[string]*ReactionConfigure    `protobuf:"bytes,1,rep,name=reactions" json:"reactions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Observations map[string]*ObservationConfigure `protobuf:"bytes,2,rep,name=observations" json:"observations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DryRun       bool                             `protobuf:"varint,3,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
 }
 
 func (m *Configure) Reset()                    { *m = Configure{} }
 func (m *Configure) String() string            { return proto1.CompactTextString(m) }
 func (*Configure) ProtoMessage()               {}
-func (*Configure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*Configure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *Configure) GetReactions() map[string]*ReactionConfigure {
 	if m != nil {
@@ -1315,6 +2233,13 @@ func (m *Configure) GetObservations() map[string]*ObservationConfigure {
 	return nil
 }
 
+func (m *Configure) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 // ******************* Environmental Profile *******************
 type CPUProfile struct {
 	Percent float64 `protobuf:"fixed64,1,opt,name=percent" json:"percent,omitempty"`
@@ -1323,7 +2248,7 @@ type CPUProfile struct {
 func (m *CPUProfile) Reset()                    { *m = CPUProfile{} }
 func (m *CPUProfile) String() string            { return proto1.CompactTextString(m) }
 func (*CPUProfile) ProtoMessage()               {}
-func (*CPUProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*CPUProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *CPUProfile) GetPercent() float64 {
 	if m != nil {
@@ -1343,7 +2268,7 @@ type MemProfile struct {
 func (m *MemProfile) Reset()                    { *m = MemProfile{} }
 func (m *MemProfile) String() string            { return proto1.CompactTextString(m) }
 func (*MemProfile) ProtoMessage()               {}
-func (*MemProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*MemProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *MemProfile) GetTotal() uint64 {
 	if m != nil {
@@ -1394,7 +2319,7 @@ type NetProfile struct {
 func (m *NetProfile) Reset()                    { *m = NetProfile{} }
 func (m *NetProfile) String() string            { return proto1.CompactTextString(m) }
 func (*NetProfile) ProtoMessage()               {}
-func (*NetProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*NetProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *NetProfile) GetBytesSent() uint64 {
 	if m != nil {
@@ -1461,7 +2386,7 @@ type HardwareProfile struct {
 func (m *HardwareProfile) Reset()                    { *m = HardwareProfile{} }
 func (m *HardwareProfile) String() string            { return proto1.CompactTextString(m) }
 func (*HardwareProfile) ProtoMessage()               {}
-func (*HardwareProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*HardwareProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *HardwareProfile) GetCpu() *CPUProfile {
 	if m != nil {
@@ -1502,7 +2427,7 @@ type LanguageGo struct {
 func (m *LanguageGo) Reset()                    { *m = LanguageGo{} }
 func (m *LanguageGo) String() string            { return proto1.CompactTextString(m) }
 func (*LanguageGo) ProtoMessage()               {}
-func (*LanguageGo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*LanguageGo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *LanguageGo) GetHeapSys() uint64 {
 	if m != nil {
@@ -1594,7 +2519,7 @@ type LanguageJava struct {
 func (m *LanguageJava) Reset()                    { *m = LanguageJava{} }
 func (m *LanguageJava) String() string            { return proto1.CompactTextString(m) }
 func (*LanguageJava) ProtoMessage()               {}
-func (*LanguageJava) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*LanguageJava) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 type LanguageProfile struct {
 	Type LanguageType `protobuf:"varint,1,opt,name=type,enum=context_bus.LanguageType" json:"type,omitempty"`
@@ -1607,7 +2532,7 @@ type LanguageProfile struct {
 func (m *LanguageProfile) Reset()                    { *m = LanguageProfile{} }
 func (m *LanguageProfile) String() string            { return proto1.CompactTextString(m) }
 func (*LanguageProfile) ProtoMessage()               {}
-func (*LanguageProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*LanguageProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 type isLanguageProfile_Profile interface{ isLanguageProfile_Profile() }
 
@@ -1734,7 +2659,7 @@ type EnvironmentalProfile struct {
 func (m *EnvironmentalProfile) Reset()                    { *m = EnvironmentalProfile{} }
 func (m *EnvironmentalProfile) String() string            { return proto1.CompactTextString(m) }
 func (*EnvironmentalProfile) ProtoMessage()               {}
-func (*EnvironmentalProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*EnvironmentalProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *EnvironmentalProfile) GetTimestamp() int64 {
 	if m != nil {
@@ -1779,7 +2704,7 @@ type EventWhen struct {
 func (m *EventWhen) Reset()                    { *m = EventWhen{} }
 func (m *EventWhen) String() string            { return proto1.CompactTextString(m) }
 func (*EventWhen) ProtoMessage()               {}
-func (*EventWhen) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*EventWhen) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *EventWhen) GetTime() int64 {
 	if m != nil {
@@ -1797,7 +2722,7 @@ type AttributeValue struct {
 func (m *AttributeValue) Reset()                    { *m = AttributeValue{} }
 func (m *AttributeValue) String() string            { return proto1.CompactTextString(m) }
 func (*AttributeValue) ProtoMessage()               {}
-func (*AttributeValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*AttributeValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *AttributeValue) GetType() AttributeValueType {
 	if m != nil {
@@ -1827,7 +2752,7 @@ type Attributes struct {
 func (m *Attributes) Reset()                    { *m = Attributes{} }
 func (m *Attributes) String() string            { return proto1.CompactTextString(m) }
 func (*Attributes) ProtoMessage()               {}
-func (*Attributes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*Attributes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *Attributes) GetAttrs() map[string]*AttributeValue {
 	if m != nil {
@@ -1845,7 +2770,7 @@ type CodeBaseInfo struct {
 func (m *CodeBaseInfo) Reset()                    { *m = CodeBaseInfo{} }
 func (m *CodeBaseInfo) String() string            { return proto1.CompactTextString(m) }
 func (*CodeBaseInfo) ProtoMessage()               {}
-func (*CodeBaseInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*CodeBaseInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *CodeBaseInfo) GetName() string {
 	if m != nil {
@@ -1869,14 +2794,15 @@ func (m *CodeBaseInfo) GetLine() int64 {
 }
 
 type EventWhere struct {
-	Attrs      *Attributes `protobuf:"bytes,1,opt,name=attrs" json:"attrs,omitempty"`
-	Stacktrace string      `protobuf:"bytes,2,opt,name=stacktrace" json:"stacktrace,omitempty"`
+	Attrs      *Attributes   `protobuf:"bytes,1,opt,name=attrs" json:"attrs,omitempty"`
+	Stacktrace string        `protobuf:"bytes,2,opt,name=stacktrace" json:"stacktrace,omitempty"`
+	Codebase   *CodeBaseInfo `protobuf:"bytes,3,opt,name=codebase" json:"codebase,omitempty"`
 }
 
 func (m *EventWhere) Reset()                    { *m = EventWhere{} }
 func (m *EventWhere) String() string            { return proto1.CompactTextString(m) }
 func (*EventWhere) ProtoMessage()               {}
-func (*EventWhere) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*EventWhere) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *EventWhere) GetAttrs() *Attributes {
 	if m != nil {
@@ -1892,15 +2818,23 @@ func (m *EventWhere) GetStacktrace() string {
 	return ""
 }
 
+func (m *EventWhere) GetCodebase() *CodeBaseInfo {
+	if m != nil {
+		return m.Codebase
+	}
+	return nil
+}
+
 type EventRecorder struct {
-	Type EventRecorderType `protobuf:"varint,1,opt,name=type,enum=context_bus.EventRecorderType" json:"type,omitempty"`
-	Name string            `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Type     EventRecorderType `protobuf:"varint,1,opt,name=type,enum=context_bus.EventRecorderType" json:"type,omitempty"`
+	Name     string            `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Severity Severity          `protobuf:"varint,3,opt,name=severity,enum=context_bus.Severity" json:"severity,omitempty"`
 }
 
 func (m *EventRecorder) Reset()                    { *m = EventRecorder{} }
 func (m *EventRecorder) String() string            { return proto1.CompactTextString(m) }
 func (*EventRecorder) ProtoMessage()               {}
-func (*EventRecorder) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*EventRecorder) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *EventRecorder) GetType() EventRecorderType {
 	if m != nil {
@@ -1916,6 +2850,13 @@ func (m *EventRecorder) GetName() string {
 	return ""
 }
 
+func (m *EventRecorder) GetSeverity() Severity {
+	if m != nil {
+		return m.Severity
+	}
+	return Severity_Severity_
+}
+
 type EventMessage struct {
 	Attrs   *Attributes `protobuf:"bytes,1,opt,name=attrs" json:"attrs,omitempty"`
 	Message string      `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -1925,7 +2866,7 @@ type EventMessage struct {
 func (m *EventMessage) Reset()                    { *m = EventMessage{} }
 func (m *EventMessage) String() string            { return proto1.CompactTextString(m) }
 func (*EventMessage) ProtoMessage()               {}
-func (*EventMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*EventMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *EventMessage) GetAttrs() *Attributes {
 	if m != nil {
@@ -1955,7 +2896,7 @@ type LibrariesMessage struct {
 func (m *LibrariesMessage) Reset()                    { *m = LibrariesMessage{} }
 func (m *LibrariesMessage) String() string            { return proto1.CompactTextString(m) }
 func (*LibrariesMessage) ProtoMessage()               {}
-func (*LibrariesMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*LibrariesMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *LibrariesMessage) GetLibraries() map[string]*EventMessage {
 	if m != nil {
@@ -1972,7 +2913,7 @@ type EventWhat struct {
 func (m *EventWhat) Reset()                    { *m = EventWhat{} }
 func (m *EventWhat) String() string            { return proto1.CompactTextString(m) }
 func (*EventWhat) ProtoMessage()               {}
-func (*EventWhat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*EventWhat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 func (m *EventWhat) GetApplication() *EventMessage {
 	if m != nil {
@@ -1998,7 +2939,7 @@ type EventRepresentation struct {
 func (m *EventRepresentation) Reset()                    { *m = EventRepresentation{} }
 func (m *EventRepresentation) String() string            { return proto1.CompactTextString(m) }
 func (*EventRepresentation) ProtoMessage()               {}
-func (*EventRepresentation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*EventRepresentation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *EventRepresentation) GetWhen() *EventWhen {
 	if m != nil {
@@ -2037,7 +2978,7 @@ type ParentChildPointers struct {
 func (m *ParentChildPointers) Reset()                    { *m = ParentChildPointers{} }
 func (m *ParentChildPointers) String() string            { return proto1.CompactTextString(m) }
 func (*ParentChildPointers) ProtoMessage()               {}
-func (*ParentChildPointers) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*ParentChildPointers) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *ParentChildPointers) GetParent() uint64 {
 	if m != nil {
@@ -2065,7 +3006,7 @@ type SpanMetadata struct {
 func (m *SpanMetadata) Reset()                    { *m = SpanMetadata{} }
 func (m *SpanMetadata) String() string            { return proto1.CompactTextString(m) }
 func (*SpanMetadata) ProtoMessage()               {}
-func (*SpanMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*SpanMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *SpanMetadata) GetSampled() bool {
 	if m != nil {
@@ -2119,7 +3060,7 @@ type EventMetadata struct {
 func (m *EventMetadata) Reset()                    { *m = EventMetadata{} }
 func (m *EventMetadata) String() string            { return proto1.CompactTextString(m) }
 func (*EventMetadata) ProtoMessage()               {}
-func (*EventMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*EventMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *EventMetadata) GetReqId() uint64 {
 	if m != nil {
@@ -2161,7 +3102,7 @@ type EventData struct {
 func (m *EventData) Reset()                    { *m = EventData{} }
 func (m *EventData) String() string            { return proto1.CompactTextString(m) }
 func (*EventData) ProtoMessage()               {}
-func (*EventData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*EventData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 func (m *EventData) GetEvent() *EventRepresentation {
 	if m != nil {
@@ -2203,7 +3144,7 @@ type Record struct {
 func (m *Record) Reset()                    { *m = Record{} }
 func (m *Record) String() string            { return proto1.CompactTextString(m) }
 func (*Record) ProtoMessage()               {}
-func (*Record) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*Record) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
 func (m *Record) GetType() ActionType {
 	if m != nil {
@@ -2254,7 +3195,7 @@ type PrometheusOpts struct {
 func (m *PrometheusOpts) Reset()                    { *m = PrometheusOpts{} }
 func (m *PrometheusOpts) String() string            { return proto1.CompactTextString(m) }
 func (*PrometheusOpts) ProtoMessage()               {}
-func (*PrometheusOpts) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*PrometheusOpts) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *PrometheusOpts) GetId() int64 {
 	if m != nil {
@@ -2319,7 +3260,7 @@ type PrometheusHistogramOpts struct {
 func (m *PrometheusHistogramOpts) Reset()                    { *m = PrometheusHistogramOpts{} }
 func (m *PrometheusHistogramOpts) String() string            { return proto1.CompactTextString(m) }
 func (*PrometheusHistogramOpts) ProtoMessage()               {}
-func (*PrometheusHistogramOpts) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*PrometheusHistogramOpts) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 func (m *PrometheusHistogramOpts) GetId() int64 {
 	if m != nil {
@@ -2386,7 +3327,7 @@ type PrometheusSummaryObjective struct {
 func (m *PrometheusSummaryObjective) Reset()                    { *m = PrometheusSummaryObjective{} }
 func (m *PrometheusSummaryObjective) String() string            { return proto1.CompactTextString(m) }
 func (*PrometheusSummaryObjective) ProtoMessage()               {}
-func (*PrometheusSummaryObjective) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*PrometheusSummaryObjective) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 func (m *PrometheusSummaryObjective) GetId() int64 {
 	if m != nil {
@@ -2426,7 +3367,7 @@ type PrometheusSummaryOpts struct {
 func (m *PrometheusSummaryOpts) Reset()                    { *m = PrometheusSummaryOpts{} }
 func (m *PrometheusSummaryOpts) String() string            { return proto1.CompactTextString(m) }
 func (*PrometheusSummaryOpts) ProtoMessage()               {}
-func (*PrometheusSummaryOpts) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*PrometheusSummaryOpts) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
 
 func (m *PrometheusSummaryOpts) GetId() int64 {
 	if m != nil {
@@ -2515,7 +3456,7 @@ type PrometheusConfiguration struct {
 func (m *PrometheusConfiguration) Reset()                    { *m = PrometheusConfiguration{} }
 func (m *PrometheusConfiguration) String() string            { return proto1.CompactTextString(m) }
 func (*PrometheusConfiguration) ProtoMessage()               {}
-func (*PrometheusConfiguration) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*PrometheusConfiguration) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
 func (m *PrometheusConfiguration) GetCounters() []*PrometheusOpts {
 	if m != nil {
@@ -2558,7 +3499,7 @@ type LatencyMetric struct {
 func (m *LatencyMetric) Reset()                    { *m = LatencyMetric{} }
 func (m *LatencyMetric) String() string            { return proto1.CompactTextString(m) }
 func (*LatencyMetric) ProtoMessage()               {}
-func (*LatencyMetric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*LatencyMetric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
 
 func (m *LatencyMetric) GetTotal() int64 {
 	if m != nil {
@@ -2611,7 +3552,7 @@ type CBLatency struct {
 func (m *CBLatency) Reset()                    { *m = CBLatency{} }
 func (m *CBLatency) String() string            { return proto1.CompactTextString(m) }
 func (*CBLatency) ProtoMessage()               {}
-func (*CBLatency) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*CBLatency) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
 
 func (m *CBLatency) GetType() int64 {
 	if m != nil {
@@ -2644,7 +3585,7 @@ type CBLatencyMetric struct {
 func (m *CBLatencyMetric) Reset()                    { *m = CBLatencyMetric{} }
 func (m *CBLatencyMetric) String() string            { return proto1.CompactTextString(m) }
 func (*CBLatencyMetric) ProtoMessage()               {}
-func (*CBLatencyMetric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*CBLatencyMetric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
 func (m *CBLatencyMetric) GetTotal() int64 {
 	if m != nil {
@@ -2682,7 +3623,7 @@ type PerfMetric struct {
 func (m *PerfMetric) Reset()                    { *m = PerfMetric{} }
 func (m *PerfMetric) String() string            { return proto1.CompactTextString(m) }
 func (*PerfMetric) ProtoMessage()               {}
-func (*PerfMetric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*PerfMetric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
 func (m *PerfMetric) GetCBLatency() *CBLatencyMetric {
 	if m != nil {
@@ -2716,7 +3657,7 @@ type Payload struct {
 func (m *Payload) Reset()                    { *m = Payload{} }
 func (m *Payload) String() string            { return proto1.CompactTextString(m) }
 func (*Payload) ProtoMessage()               {}
-func (*Payload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*Payload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
 func (m *Payload) GetRequestId() uint64 {
 	if m != nil {
@@ -2786,8 +3727,11 @@ func init() {
 	proto1.RegisterType((*ConditionLogic)(nil), "context_bus.ConditionLogic")
 	proto1.RegisterType((*ConditionNode)(nil), "context_bus.ConditionNode")
 	proto1.RegisterType((*ConditionTree)(nil), "context_bus.ConditionTree")
+	proto1.RegisterType((*AttributeCondition)(nil), "context_bus.AttributeCondition")
 	proto1.RegisterType((*PrerequisiteMessage)(nil), "context_bus.PrerequisiteMessage")
 	proto1.RegisterType((*PrerequisiteEvent)(nil), "context_bus.PrerequisiteEvent")
+	proto1.RegisterType((*PrerequisiteSequence)(nil), "context_bus.PrerequisiteSequence")
+	proto1.RegisterType((*PrerequisiteProfile)(nil), "context_bus.PrerequisiteProfile")
 	proto1.RegisterType((*PrerequisiteLogic)(nil), "context_bus.PrerequisiteLogic")
 	proto1.RegisterType((*PrerequisiteNode)(nil), "context_bus.PrerequisiteNode")
 	proto1.RegisterType((*PrerequisiteTree)(nil), "context_bus.PrerequisiteTree")
@@ -2796,12 +3740,20 @@ func init() {
 	proto1.RegisterType((*FaultDelayParam)(nil), "context_bus.FaultDelayParam")
 	proto1.RegisterType((*TrafficBalanceParam)(nil), "context_bus.TrafficBalanceParam")
 	proto1.RegisterType((*TrafficRoutingParam)(nil), "context_bus.TrafficRoutingParam")
+	proto1.RegisterType((*SMTPParam)(nil), "context_bus.SMTPParam")
+	proto1.RegisterType((*WebhookParam)(nil), "context_bus.WebhookParam")
+	proto1.RegisterType((*EmailParam)(nil), "context_bus.EmailParam")
+	proto1.RegisterType((*EmitEventParam)(nil), "context_bus.EmitEventParam")
+	proto1.RegisterType((*CustomParam)(nil), "context_bus.CustomParam")
+	proto1.RegisterType((*FiringPolicy)(nil), "context_bus.FiringPolicy")
 	proto1.RegisterType((*ReactionConfigure)(nil), "context_bus.ReactionConfigure")
 	proto1.RegisterType((*Path)(nil), "context_bus.Path")
 	proto1.RegisterType((*AttributeConfigure)(nil), "context_bus.AttributeConfigure")
 	proto1.RegisterType((*TimestampConfigure)(nil), "context_bus.TimestampConfigure")
 	proto1.RegisterType((*StackTraceConfigure)(nil), "context_bus.StackTraceConfigure")
+	proto1.RegisterType((*FileConfigure)(nil), "context_bus.FileConfigure")
 	proto1.RegisterType((*LoggingConfigure)(nil), "context_bus.LoggingConfigure")
+	proto1.RegisterType((*TailLoggingConfigure)(nil), "context_bus.TailLoggingConfigure")
 	proto1.RegisterType((*TracingConfigure)(nil), "context_bus.TracingConfigure")
 	proto1.RegisterType((*MetricsConfigure)(nil), "context_bus.MetricsConfigure")
 	proto1.RegisterType((*ObservationConfigure)(nil), "context_bus.ObservationConfigure")
@@ -2844,14 +3796,19 @@ func init() {
 	proto1.RegisterEnum("context_bus.ConditionNodeType", ConditionNodeType_name, ConditionNodeType_value)
 	proto1.RegisterEnum("context_bus.LogicType", LogicType_name, LogicType_value)
 	proto1.RegisterEnum("context_bus.PrerequisiteNodeType", PrerequisiteNodeType_name, PrerequisiteNodeType_value)
+	proto1.RegisterEnum("context_bus.AttributeOperator", AttributeOperator_name, AttributeOperator_value)
 	proto1.RegisterEnum("context_bus.ReactionType", ReactionType_name, ReactionType_value)
+	proto1.RegisterEnum("context_bus.DelayDistribution", DelayDistribution_name, DelayDistribution_value)
+	proto1.RegisterEnum("context_bus.FiringMode", FiringMode_name, FiringMode_value)
 	proto1.RegisterEnum("context_bus.PathType", PathType_name, PathType_value)
 	proto1.RegisterEnum("context_bus.LogOutType", LogOutType_name, LogOutType_value)
+	proto1.RegisterEnum("context_bus.LogEncoderType", LogEncoderType_name, LogEncoderType_value)
 	proto1.RegisterEnum("context_bus.MetricType", MetricType_name, MetricType_value)
 	proto1.RegisterEnum("context_bus.ObservationType", ObservationType_name, ObservationType_value)
 	proto1.RegisterEnum("context_bus.LanguageType", LanguageType_name, LanguageType_value)
 	proto1.RegisterEnum("context_bus.AttributeValueType", AttributeValueType_name, AttributeValueType_value)
 	proto1.RegisterEnum("context_bus.EventRecorderType", EventRecorderType_name, EventRecorderType_value)
+	proto1.RegisterEnum("context_bus.Severity", Severity_name, Severity_value)
 	proto1.RegisterEnum("context_bus.MessageType", MessageType_name, MessageType_value)
 	proto1.RegisterEnum("context_bus.ActionType", ActionType_name, ActionType_value)
 }
@@ -2859,234 +3816,306 @@ func init() {
 func init() { proto1.RegisterFile("context_bus.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 3663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x4d, 0x73, 0x1c, 0x59,
-	0x52, 0xae, 0xfe, 0x52, 0x77, 0xb6, 0x3e, 0xca, 0xcf, 0x96, 0xdd, 0x23, 0x7f, 0x57, 0xec, 0xce,
-	0x7a, 0xc4, 0xac, 0x67, 0xc6, 0x9e, 0x59, 0x4f, 0xcc, 0xc0, 0xac, 0x2d, 0x59, 0xb6, 0x64, 0x64,
-	0x4b, 0x5b, 0xd2, 0xec, 0x10, 0xcc, 0x46, 0x74, 0x3c, 0x55, 0x3d, 0x75, 0xd7, 0xba, 0xbb, 0xaa,
-	0x5c, 0xf5, 0xba, 0x6d, 0x71, 0x00, 0x22, 0xf8, 0x88, 0x85, 0xcb, 0x06, 0xdc, 0x38, 0x70, 0x80,
-	0xe0, 0xc2, 0x89, 0x13, 0xcb, 0x95, 0x2b, 0xc1, 0x85, 0x00, 0x7e, 0xc0, 0xc2, 0x0d, 0x82, 0x03,
-	0x11, 0x04, 0x27, 0x2e, 0x44, 0xbe, 0x8f, 0xaa, 0xf7, 0xba, 0x4b, 0xf2, 0x0e, 0xec, 0xc6, 0x9c,
-	0xea, 0x65, 0x56, 0x66, 0xbe, 0x7c, 0x99, 0xf9, 0x5e, 0xe6, 0xcb, 0x2a, 0x38, 0x1f, 0x24, 0x31,
-	0x67, 0xaf, 0x79, 0xff, 0x68, 0x92, 0xdf, 0x49, 0xb3, 0x84, 0x27, 0xa4, 0x6b, 0xa0, 0xbc, 0x1f,
-	0x39, 0xe0, 0x6e, 0x26, 0x71, 0x18, 0xf1, 0x28, 0x89, 0x9f, 0xb1, 0x3c, 0xa7, 0x03, 0x46, 0xee,
-	0x40, 0x83, 0x9f, 0xa4, 0xac, 0xe7, 0xdc, 0x74, 0x6e, 0x2f, 0xdf, 0x5d, 0xbb, 0x63, 0xca, 0x28,
-	0x88, 0x0f, 0x4f, 0x52, 0xe6, 0x0b, 0x3a, 0x72, 0x07, 0x6a, 0x49, 0xda, 0xab, 0x09, 0xea, 0xeb,
-	0xd5, 0xd4, 0x7b, 0x29, 0xcb, 0x28, 0x4f, 0x32, 0xbf, 0x96, 0xa4, 0xe4, 0x22, 0x34, 0xa7, 0x74,
-	0x34, 0x61, 0xbd, 0xfa, 0x4d, 0xe7, 0x76, 0xdd, 0x97, 0x80, 0x37, 0x84, 0xe5, 0x82, 0x7c, 0x37,
-	0x19, 0x44, 0x01, 0x59, 0xb7, 0xf4, 0xb8, 0x64, 0x49, 0x16, 0x14, 0x86, 0x0e, 0x97, 0xa0, 0x95,
-	0xd2, 0x8c, 0xc5, 0xbc, 0x17, 0x0a, 0xa1, 0x0a, 0x22, 0x04, 0x1a, 0xa3, 0x28, 0xe7, 0x3d, 0x76,
-	0xb3, 0x7e, 0xbb, 0xee, 0x8b, 0xb1, 0xf7, 0x57, 0x0e, 0x2c, 0x15, 0x53, 0x3d, 0x4f, 0x42, 0x46,
-	0xee, 0xaa, 0x99, 0xce, 0x5c, 0x03, 0x52, 0x1a, 0x33, 0xde, 0x87, 0x85, 0xb1, 0x34, 0x98, 0x58,
-	0x47, 0xf7, 0xee, 0xb5, 0x6a, 0x36, 0x65, 0x55, 0x5f, 0x53, 0x93, 0x0f, 0xa0, 0x39, 0x42, 0xed,
-	0x7b, 0x0d, 0xc1, 0x76, 0xa5, 0x9a, 0x4d, 0x2c, 0xd0, 0x97, 0x94, 0xde, 0x97, 0x86, 0xc2, 0x87,
-	0x19, 0x63, 0xe4, 0x7d, 0x68, 0xc6, 0x49, 0xc8, 0xf2, 0x9e, 0x73, 0xb3, 0x7e, 0xbb, 0x7b, 0x9a,
-	0x8f, 0x50, 0x63, 0x5f, 0x12, 0x92, 0x1e, 0x2c, 0x8c, 0x18, 0x3d, 0xde, 0x79, 0x94, 0xf7, 0x6a,
-	0xc2, 0x16, 0x1a, 0xf4, 0x7e, 0x03, 0x2e, 0xec, 0x67, 0x2c, 0x63, 0x2f, 0x27, 0x51, 0x1e, 0x71,
-	0xa6, 0xa3, 0x80, 0x40, 0x23, 0xa6, 0x63, 0x69, 0xfd, 0x8e, 0x2f, 0xc6, 0xe4, 0x3e, 0x74, 0x82,
-	0x24, 0x0e, 0xfb, 0x3c, 0x63, 0xd2, 0x58, 0xa7, 0x4e, 0x8d, 0x5a, 0xfa, 0x6d, 0x24, 0x16, 0xfa,
-	0x9e, 0xe2, 0x1e, 0xef, 0x21, 0x9c, 0x37, 0xe7, 0xde, 0x9a, 0x2a, 0x9f, 0xcd, 0xcd, 0x8c, 0xea,
-	0x53, 0xce, 0xe2, 0xe0, 0x44, 0xcc, 0x8b, 0xea, 0x4b, 0xd0, 0x7b, 0x61, 0x8b, 0xf8, 0xc5, 0x86,
-	0xce, 0x1f, 0xd4, 0xc0, 0x35, 0x67, 0x13, 0xd1, 0xb3, 0x0c, 0xb5, 0x28, 0x14, 0x53, 0xd5, 0xfd,
-	0x5a, 0x14, 0x92, 0x8f, 0xac, 0x68, 0xba, 0x65, 0x4d, 0x3e, 0xcb, 0x6c, 0xe8, 0xf1, 0xc9, 0x6c,
-	0x40, 0xdd, 0x3c, 0x95, 0x73, 0x2e, 0xa6, 0x7e, 0x19, 0x3a, 0x69, 0xc6, 0xa6, 0xc2, 0x7e, 0x2a,
-	0xae, 0xae, 0x9f, 0xca, 0x2d, 0xa8, 0xfc, 0x92, 0x81, 0x7c, 0xa8, 0x23, 0xb2, 0xf9, 0x06, 0x4e,
-	0x2b, 0x28, 0xa9, 0x6d, 0x0a, 0xe1, 0xe7, 0x7b, 0x76, 0x5c, 0x5e, 0x3b, 0x73, 0xed, 0x6f, 0x0e,
-	0xcd, 0xcf, 0xe0, 0xa2, 0xc9, 0x74, 0x10, 0xd3, 0x34, 0x1f, 0x26, 0xbc, 0x3c, 0x41, 0x1c, 0x41,
-	0x2f, 0x01, 0xe2, 0x42, 0x9d, 0x06, 0x81, 0x30, 0x7b, 0xdb, 0xc7, 0xa1, 0xf7, 0x77, 0x0e, 0xac,
-	0x56, 0x09, 0xc8, 0xc9, 0x1e, 0x74, 0x72, 0x0d, 0x28, 0x65, 0x3f, 0x38, 0x55, 0xd9, 0x82, 0xed,
-	0x4e, 0x31, 0xda, 0x8a, 0x79, 0x76, 0xe2, 0x97, 0x32, 0xd6, 0xfa, 0xb0, 0x6c, 0xbf, 0x44, 0x75,
-	0x5e, 0xb0, 0x13, 0x15, 0xc5, 0x38, 0x24, 0xf7, 0xb5, 0xda, 0x72, 0xeb, 0xdc, 0x7a, 0xe3, 0x84,
-	0x6a, 0x65, 0x9f, 0xd4, 0x3e, 0x76, 0xbc, 0x5b, 0xb0, 0xf2, 0x98, 0x4e, 0x46, 0xfc, 0x11, 0x1b,
-	0xd1, 0x93, 0x7d, 0x9a, 0xd1, 0x31, 0x06, 0xde, 0x38, 0xd7, 0x81, 0x37, 0xce, 0xbd, 0x55, 0xb8,
-	0x70, 0x98, 0xd1, 0xe3, 0xe3, 0x28, 0xd8, 0xa0, 0x23, 0x1a, 0x07, 0x4c, 0x90, 0x19, 0x68, 0x3f,
-	0x99, 0xf0, 0x28, 0x1e, 0x48, 0xf4, 0xbf, 0xd4, 0xe0, 0xbc, 0xcf, 0x68, 0x80, 0xdb, 0x75, 0x33,
-	0x89, 0x8f, 0xa3, 0xc1, 0x24, 0x63, 0xe4, 0xdb, 0xd6, 0xce, 0x79, 0xcb, 0x52, 0x51, 0x53, 0x1b,
-	0x41, 0xfb, 0x5d, 0x80, 0x52, 0xab, 0xde, 0x3f, 0xae, 0x88, 0x85, 0x5d, 0xb5, 0xb8, 0x66, 0xb4,
-	0xde, 0x3e, 0xe7, 0x1b, 0x2c, 0xe4, 0x57, 0x61, 0xd9, 0xd6, 0xb9, 0xf7, 0xd7, 0x6e, 0x45, 0xf4,
-	0x57, 0xac, 0x6b, 0xfb, 0x9c, 0x3f, 0xc3, 0x6a, 0x08, 0x53, 0x2b, 0xed, 0xfd, 0xe4, 0x0c, 0x61,
-	0xa6, 0x35, 0x0c, 0x61, 0x0a, 0x4d, 0x3e, 0x86, 0x76, 0x9a, 0x31, 0x79, 0xd6, 0x55, 0x9d, 0xf0,
-	0xb3, 0xc1, 0xef, 0x2f, 0xa4, 0x99, 0x18, 0x6c, 0xb4, 0xc5, 0x89, 0x42, 0xc7, 0xb9, 0xb7, 0x05,
-	0x8d, 0x7d, 0xca, 0x87, 0xe4, 0x1d, 0xcb, 0xaa, 0xab, 0xb6, 0x1c, 0xca, 0x87, 0x86, 0x45, 0x09,
-	0x34, 0x52, 0xca, 0x87, 0x62, 0x2b, 0x74, 0x7c, 0x31, 0xf6, 0xf6, 0x80, 0x3c, 0xe4, 0x3c, 0x8b,
-	0x8e, 0x26, 0x9c, 0x95, 0xae, 0xaa, 0x3a, 0x27, 0xbf, 0x59, 0x70, 0xa3, 0xc2, 0xe7, 0xe7, 0x26,
-	0x52, 0x02, 0xdf, 0x05, 0x72, 0x18, 0x8d, 0x59, 0xce, 0xe9, 0x38, 0x2d, 0x05, 0x5e, 0x82, 0xd6,
-	0x71, 0x92, 0x8d, 0x29, 0x57, 0x22, 0x15, 0xe4, 0x7d, 0x1b, 0x2e, 0x1c, 0x70, 0x1a, 0xbc, 0x38,
-	0xcc, 0x68, 0xc0, 0x2c, 0xf2, 0xfc, 0x55, 0xc4, 0x83, 0xa1, 0x20, 0x6f, 0xfb, 0x0a, 0xf2, 0xfe,
-	0xdb, 0x01, 0x77, 0x37, 0x19, 0x0c, 0xa2, 0x78, 0x50, 0x12, 0xff, 0x0a, 0x74, 0xb8, 0x9e, 0x51,
-	0xd0, 0x77, 0xef, 0xde, 0xb0, 0x9d, 0x32, 0xa7, 0x8f, 0x5f, 0x72, 0x90, 0x07, 0x00, 0x39, 0xaa,
-	0xc0, 0x51, 0x05, 0xb5, 0x3a, 0xdb, 0xa9, 0x15, 0x1a, 0xfa, 0x06, 0x0f, 0xf9, 0x08, 0x9a, 0x94,
-	0xf3, 0x2c, 0xef, 0xd5, 0xc5, 0x6e, 0xb7, 0x27, 0x9f, 0xb7, 0xae, 0x2f, 0xa9, 0xc9, 0x3b, 0x50,
-	0x4f, 0x26, 0xf2, 0x4c, 0x5d, 0xbe, 0x7b, 0x79, 0x36, 0x91, 0xec, 0x4d, 0xb8, 0x70, 0x1d, 0xd2,
-	0x78, 0x7f, 0x52, 0x03, 0x17, 0x15, 0xb0, 0xd6, 0x7d, 0x11, 0x9a, 0x39, 0xa7, 0x19, 0x57, 0x36,
-	0x92, 0x00, 0x9e, 0x0d, 0x2c, 0x0e, 0xf5, 0x51, 0xc5, 0xe2, 0x90, 0x5c, 0x81, 0x4e, 0x9e, 0xd2,
-	0xb8, 0x2f, 0x3c, 0x5a, 0x17, 0xe6, 0x6f, 0x23, 0xe2, 0x39, 0x7a, 0xf5, 0x6d, 0x58, 0xc1, 0xd3,
-	0xba, 0xcf, 0xf0, 0xb8, 0x96, 0x24, 0x0d, 0x41, 0xb2, 0x54, 0x1c, 0xe2, 0x82, 0xae, 0x58, 0x63,
-	0xf3, 0x2b, 0xad, 0xd1, 0x36, 0x6e, 0xeb, 0xff, 0x60, 0xdc, 0x1b, 0xd0, 0x95, 0x59, 0x53, 0x2a,
-	0xd7, 0x15, 0xca, 0x81, 0x44, 0xa1, 0x66, 0xde, 0xdf, 0x3a, 0xe0, 0x3e, 0x63, 0x3c, 0x8b, 0x82,
-	0xbc, 0xb4, 0xcd, 0x2f, 0x59, 0xbb, 0xc2, 0x36, 0xae, 0x24, 0x36, 0xf6, 0xc5, 0x65, 0x58, 0x48,
-	0x52, 0x9e, 0xf7, 0xa3, 0x50, 0x55, 0x00, 0x2d, 0x04, 0x77, 0xc2, 0x62, 0x1b, 0xd4, 0x8d, 0x6d,
-	0x70, 0x45, 0xe6, 0x43, 0xd3, 0x54, 0xb8, 0x99, 0xa7, 0xff, 0x0f, 0x2b, 0x79, 0xff, 0xe5, 0xc0,
-	0xc5, 0xbd, 0xa3, 0x9c, 0x65, 0x53, 0x6a, 0x1f, 0x99, 0xef, 0x5b, 0xcb, 0xb0, 0x0f, 0x3f, 0x83,
-	0xc1, 0xae, 0x1d, 0x47, 0x72, 0x83, 0xa8, 0x50, 0xbe, 0x36, 0x1b, 0x58, 0xd6, 0xe6, 0xf1, 0x35,
-	0x35, 0x32, 0x72, 0x19, 0x61, 0x95, 0x47, 0xd2, 0x6c, 0xf4, 0xf9, 0x9a, 0x5a, 0x56, 0xab, 0xc2,
-	0xfc, 0xbd, 0x46, 0x45, 0x6a, 0x9e, 0x75, 0x8d, 0xaf, 0xa9, 0xbd, 0x9f, 0xd6, 0xa0, 0x53, 0x2e,
-	0x75, 0x13, 0x3a, 0x99, 0x4a, 0x02, 0x3a, 0x6d, 0x7e, 0x73, 0xb6, 0x00, 0x94, 0xa4, 0x45, 0xb2,
-	0xd0, 0xa9, 0xb2, 0xe0, 0x23, 0xbb, 0xb0, 0x98, 0x94, 0x66, 0x91, 0x49, 0xbf, 0x7b, 0xf7, 0xf6,
-	0x29, 0x72, 0x0c, 0x0b, 0x2a, 0x51, 0x16, 0xf7, 0xda, 0x0f, 0x60, 0xd9, 0x9e, 0xaa, 0x22, 0xf1,
-	0x7e, 0x68, 0x27, 0xde, 0xeb, 0x95, 0x59, 0xcd, 0x70, 0x78, 0x91, 0x75, 0xd7, 0x8e, 0xe0, 0xfc,
-	0x9c, 0x02, 0x5f, 0x35, 0xb3, 0x57, 0x05, 0x8d, 0x99, 0xd9, 0xdf, 0x06, 0xd8, 0xdc, 0xff, 0x7c,
-	0x3f, 0x4b, 0x8e, 0xa3, 0x91, 0xa8, 0x74, 0x53, 0x96, 0x05, 0x58, 0xc8, 0xe1, 0x04, 0x8e, 0xaf,
-	0x41, 0xef, 0x0f, 0x1d, 0x80, 0x67, 0x6c, 0xac, 0x09, 0x2f, 0x42, 0x93, 0x27, 0x9c, 0x8e, 0x04,
-	0x59, 0xc3, 0x97, 0x00, 0xb9, 0x0a, 0x1d, 0x3a, 0xa5, 0xd1, 0x88, 0x1e, 0x8d, 0xa4, 0x36, 0x0d,
-	0xbf, 0x44, 0xe0, 0x5e, 0x99, 0xe4, 0x2c, 0x14, 0xc1, 0xd3, 0xf0, 0xc5, 0x98, 0xdc, 0x84, 0x2e,
-	0x3e, 0xf7, 0xd5, 0xa4, 0x0d, 0x31, 0xa9, 0x89, 0x42, 0xae, 0x63, 0xcc, 0x82, 0x4d, 0xc9, 0x85,
-	0x63, 0xef, 0x3f, 0x1c, 0x80, 0xe7, 0x8c, 0x6b, 0x65, 0xae, 0x42, 0xe7, 0xe8, 0x84, 0xb3, 0xfc,
-	0x40, 0xeb, 0xdd, 0xf0, 0x4b, 0x44, 0xf1, 0xd6, 0x67, 0xc1, 0x54, 0x2b, 0x55, 0x20, 0x50, 0x81,
-	0x94, 0x06, 0x2f, 0x18, 0x97, 0xdc, 0x52, 0x37, 0x13, 0x65, 0x50, 0x08, 0x09, 0x0d, 0x8b, 0x42,
-	0xc8, 0xb8, 0x08, 0x4d, 0x96, 0x65, 0x51, 0xac, 0x74, 0x94, 0x00, 0x66, 0x28, 0x96, 0x65, 0x78,
-	0x7e, 0xb7, 0x04, 0x5a, 0x41, 0x88, 0x0f, 0xb3, 0x24, 0x8d, 0xe2, 0xde, 0x82, 0xc4, 0x4b, 0x08,
-	0x6d, 0x8f, 0x23, 0x64, 0x68, 0x8b, 0x17, 0x1a, 0xf4, 0xfe, 0xd8, 0x81, 0x95, 0x6d, 0x9a, 0x85,
-	0xaf, 0x68, 0xc6, 0xf4, 0x9a, 0xdf, 0x81, 0x7a, 0x90, 0x4e, 0x54, 0x32, 0xb3, 0x4f, 0xaf, 0xd2,
-	0x9f, 0x3e, 0xd2, 0x20, 0xe9, 0x98, 0x8d, 0x55, 0x74, 0xcc, 0x1e, 0x74, 0xe3, 0x82, 0x74, 0xcc,
-	0xc6, 0x48, 0x1a, 0x33, 0xae, 0xb6, 0xb7, 0x4d, 0x5a, 0xda, 0xdb, 0x47, 0x1a, 0xef, 0x3f, 0x6b,
-	0x00, 0xbb, 0x34, 0x1e, 0x4c, 0xe8, 0x80, 0x3d, 0x49, 0x50, 0xfb, 0x6d, 0x46, 0xd3, 0x83, 0x93,
-	0x5c, 0x79, 0x40, 0x83, 0x68, 0x7f, 0x1c, 0x3e, 0x1c, 0x8d, 0x92, 0x40, 0xdb, 0xbf, 0x40, 0xe8,
-	0xb7, 0x3b, 0xf1, 0x24, 0x67, 0xca, 0xfa, 0x25, 0x82, 0xac, 0x41, 0x5b, 0x9c, 0xfe, 0x28, 0x56,
-	0x1a, 0xbe, 0x80, 0xc9, 0x75, 0x00, 0x31, 0x96, 0xac, 0xd2, 0xf4, 0x06, 0x06, 0xdf, 0x3f, 0x3b,
-	0x48, 0x69, 0x2c, 0xdf, 0x4b, 0x1f, 0x18, 0x18, 0x94, 0x2d, 0x20, 0x94, 0x2d, 0x3d, 0x51, 0xc0,
-	0xe8, 0xf3, 0x67, 0x9b, 0x34, 0x18, 0x32, 0xc9, 0x2c, 0xfd, 0x61, 0xa2, 0x50, 0x6f, 0x09, 0x22,
-	0x7b, 0x47, 0xea, 0x5d, 0x20, 0xd0, 0xc7, 0xbb, 0x34, 0xe7, 0x4f, 0x36, 0x7b, 0x4c, 0xfa, 0x58,
-	0x42, 0x88, 0x7f, 0xce, 0x5e, 0x23, 0xfe, 0x58, 0xe2, 0x25, 0x44, 0xbe, 0x01, 0x4b, 0x4f, 0x36,
-	0x37, 0xf7, 0x3f, 0x7f, 0x9c, 0xc9, 0xe3, 0xa0, 0x37, 0x10, 0x1b, 0xc1, 0x46, 0x7a, 0xcb, 0xb0,
-	0xa8, 0x2d, 0xfe, 0x94, 0x4e, 0xa9, 0xf7, 0x97, 0x0e, 0xac, 0x68, 0x84, 0x8e, 0x8b, 0xb3, 0x4a,
-	0x68, 0x4d, 0x6b, 0x24, 0x83, 0x75, 0xa8, 0x0d, 0x12, 0x5d, 0x3a, 0x5f, 0xae, 0xa4, 0x7e, 0x92,
-	0x6c, 0x9f, 0xf3, 0x6b, 0x83, 0x04, 0x53, 0xcd, 0x0f, 0xe9, 0x94, 0xf6, 0xfe, 0x49, 0x52, 0x57,
-	0xcb, 0x46, 0xc5, 0xb6, 0xcf, 0xf9, 0x82, 0x72, 0xa3, 0x03, 0x0b, 0x4a, 0x2f, 0xef, 0x1f, 0x1c,
-	0xb8, 0xb8, 0x15, 0x4f, 0xa3, 0x2c, 0x89, 0xc7, 0x2c, 0xe6, 0x74, 0x64, 0x6c, 0x5e, 0xbb, 0x36,
-	0xab, 0x9b, 0xa5, 0xd7, 0xc7, 0xd0, 0x1e, 0xaa, 0xc8, 0x57, 0x01, 0x6c, 0xa7, 0xb8, 0x99, 0x6d,
-	0xe1, 0x17, 0xd4, 0xc8, 0x39, 0x52, 0x3a, 0xa9, 0x78, 0xbe, 0x5a, 0xa9, 0x70, 0xc1, 0xa9, 0xa9,
-	0x45, 0x11, 0x9c, 0xb1, 0xa9, 0x70, 0x5d, 0xdd, 0x17, 0x63, 0x91, 0xe7, 0xd9, 0x6b, 0x2e, 0xdc,
-	0x56, 0xf7, 0xc5, 0xd8, 0xbb, 0x01, 0x1d, 0x51, 0xfd, 0x7c, 0x31, 0x64, 0x31, 0x12, 0xa0, 0xd6,
-	0x6a, 0x05, 0x62, 0xec, 0xfd, 0xc8, 0x81, 0xe5, 0x22, 0xa5, 0x7f, 0x5f, 0x5c, 0x13, 0xef, 0x59,
-	0xee, 0x39, 0x25, 0xfb, 0x0b, 0x52, 0xc3, 0x49, 0x2e, 0xd4, 0x73, 0x9e, 0x89, 0xf5, 0x77, 0x7c,
-	0x1c, 0x92, 0xf7, 0xa0, 0x95, 0xf3, 0x6c, 0x12, 0x54, 0x6f, 0xd5, 0x42, 0x50, 0xee, 0x2b, 0x32,
-	0xef, 0x4f, 0x1d, 0x80, 0x12, 0x4d, 0x3e, 0xd6, 0x55, 0x88, 0x4c, 0xa3, 0xde, 0x29, 0xec, 0x62,
-	0xa8, 0x12, 0x9f, 0x64, 0x58, 0xfb, 0x5c, 0xca, 0x39, 0x35, 0x19, 0x7d, 0x60, 0x27, 0xa3, 0x2b,
-	0x67, 0xac, 0xd0, 0x4c, 0x43, 0x4f, 0x61, 0x71, 0x33, 0x09, 0xd9, 0x06, 0xcd, 0xd9, 0x4e, 0x7c,
-	0x9c, 0x54, 0x5e, 0x2f, 0x30, 0x13, 0x44, 0x2a, 0xb1, 0x74, 0x7c, 0x31, 0x96, 0x7d, 0x92, 0x58,
-	0x77, 0xf3, 0xc4, 0xd8, 0xfb, 0x12, 0x40, 0xfb, 0x45, 0xdc, 0x29, 0x8b, 0xa5, 0x9e, 0x69, 0x29,
-	0x55, 0x8e, 0x5e, 0x9f, 0xab, 0xf5, 0x3b, 0x66, 0xb1, 0xe9, 0x7d, 0x01, 0x4b, 0xb2, 0x85, 0xc1,
-	0x82, 0x24, 0x0b, 0x59, 0x56, 0xb4, 0xef, 0x9c, 0x8a, 0xf6, 0x9d, 0x45, 0x69, 0x5f, 0xb3, 0xc4,
-	0xea, 0x6a, 0xe5, 0xea, 0xbc, 0xdf, 0x76, 0x60, 0x51, 0xd0, 0xeb, 0x1e, 0xd8, 0x57, 0x54, 0xbc,
-	0x57, 0x76, 0x70, 0xa4, 0xd8, 0xa2, 0x3f, 0xf3, 0x2d, 0x68, 0xe2, 0xbd, 0x4b, 0x5f, 0x3e, 0x2a,
-	0xee, 0x65, 0xf2, 0xbd, 0xf7, 0x37, 0x78, 0x77, 0x8a, 0x8e, 0x32, 0x9a, 0x45, 0x2c, 0xd7, 0x6a,
-	0x3c, 0x85, 0xce, 0x48, 0xe3, 0x54, 0xb8, 0xbc, 0x6b, 0x6f, 0xa4, 0x19, 0x8e, 0x12, 0xa1, 0x8a,
-	0xaf, 0x82, 0x7d, 0xed, 0x0b, 0x58, 0xb6, 0x5f, 0x56, 0x04, 0xd0, 0x7b, 0x76, 0x00, 0xbd, 0x35,
-	0x6f, 0x50, 0xdd, 0x80, 0x32, 0xc2, 0xe7, 0xf7, 0x9c, 0x62, 0x2f, 0x52, 0x4e, 0x3e, 0x85, 0x2e,
-	0x4d, 0xd3, 0x51, 0x14, 0x88, 0xb2, 0x47, 0xd9, 0xef, 0x0c, 0x41, 0x26, 0x35, 0xf9, 0xd4, 0x5c,
-	0x6f, 0x65, 0x81, 0x3c, 0xb3, 0x5e, 0x63, 0x81, 0xde, 0x3f, 0x3b, 0x70, 0x41, 0x39, 0x3d, 0xcd,
-	0x58, 0x8e, 0x07, 0x9d, 0x10, 0xba, 0x0e, 0x8d, 0x57, 0x43, 0xa6, 0x55, 0xb9, 0x34, 0xaf, 0x0a,
-	0x9e, 0x21, 0xbe, 0xa0, 0x41, 0xbf, 0xbf, 0xc2, 0xc8, 0xad, 0x4c, 0xd8, 0x65, 0x60, 0xfb, 0x92,
-	0x8a, 0x7c, 0x07, 0xda, 0x99, 0x8a, 0x30, 0x75, 0x18, 0xac, 0x9d, 0x1e, 0x83, 0x7e, 0x41, 0x2b,
-	0x55, 0xa2, 0xba, 0x61, 0x57, 0xa9, 0x12, 0xe5, 0xbe, 0xa0, 0xf1, 0x76, 0xe0, 0xc2, 0xbe, 0xb8,
-	0x4e, 0x6d, 0x0e, 0xa3, 0x51, 0xb8, 0x9f, 0x44, 0x31, 0x67, 0x59, 0x6e, 0x34, 0x2f, 0x65, 0xca,
-	0xd7, 0xcd, 0xcb, 0x35, 0x68, 0x07, 0x48, 0x98, 0xb1, 0x58, 0xd4, 0xd7, 0x0d, 0xbf, 0x80, 0xbd,
-	0x3f, 0xaf, 0xc1, 0x22, 0x66, 0xd9, 0x67, 0x8c, 0xd3, 0x90, 0x72, 0x8a, 0x71, 0x9b, 0xd3, 0x71,
-	0x3a, 0x62, 0xa1, 0xba, 0xa5, 0x6a, 0x90, 0x78, 0xb0, 0x24, 0xf6, 0x5c, 0x3f, 0x0a, 0xfb, 0xc3,
-	0x68, 0x30, 0x54, 0xc5, 0x43, 0x57, 0x20, 0x77, 0xc2, 0xed, 0x68, 0x30, 0x24, 0x37, 0x61, 0xb1,
-	0xa0, 0x19, 0x25, 0xaf, 0x54, 0x05, 0x01, 0x8a, 0x64, 0x37, 0x79, 0x85, 0x57, 0x37, 0x71, 0xb7,
-	0x8d, 0x42, 0x55, 0x41, 0xb4, 0x10, 0xdc, 0x11, 0x97, 0x5e, 0x75, 0x6d, 0x8c, 0x42, 0x55, 0x3e,
-	0xb4, 0x25, 0x62, 0x27, 0x24, 0x0f, 0x60, 0xe1, 0x88, 0x0e, 0x06, 0xb8, 0x9b, 0x5a, 0x22, 0xe6,
-	0xdf, 0xb6, 0xaf, 0xa4, 0xc6, 0x0a, 0xee, 0x6c, 0x48, 0x42, 0x19, 0xed, 0x9a, 0x6d, 0xed, 0x13,
-	0x58, 0x34, 0x5f, 0x54, 0x44, 0xfa, 0x45, 0x33, 0xd2, 0x3b, 0x66, 0x38, 0xff, 0x8e, 0xa3, 0x4e,
-	0x99, 0xc2, 0x4a, 0xab, 0xd0, 0xca, 0xd8, 0xcb, 0xbe, 0x6a, 0xf5, 0x36, 0xfc, 0x66, 0xc6, 0x5e,
-	0xee, 0x84, 0x88, 0x66, 0x53, 0xa6, 0xaf, 0xa5, 0x58, 0x7a, 0x4e, 0xd9, 0x4e, 0x48, 0xee, 0x42,
-	0x3d, 0x0d, 0x52, 0x71, 0x13, 0x9e, 0xeb, 0xe4, 0xce, 0xfb, 0xd1, 0x47, 0x62, 0xd1, 0x15, 0xc8,
-	0xd3, 0xde, 0xa2, 0x38, 0x48, 0x71, 0xe8, 0xfd, 0x8f, 0xde, 0x54, 0x8f, 0x50, 0x83, 0xef, 0x40,
-	0x53, 0x74, 0x00, 0x54, 0x0c, 0xdf, 0xac, 0x0a, 0x32, 0x33, 0xe6, 0x7d, 0x49, 0x8e, 0xf1, 0x39,
-	0x56, 0xab, 0xa8, 0xec, 0xda, 0x5b, 0xeb, 0xf4, 0x0b, 0x5a, 0xf2, 0x99, 0xd5, 0x76, 0x10, 0xec,
-	0xdd, 0xd3, 0x42, 0x15, 0x15, 0x34, 0xda, 0x11, 0x8f, 0x24, 0xff, 0x92, 0xf0, 0x7b, 0x31, 0xf9,
-	0x62, 0xc5, 0x31, 0x60, 0xfa, 0xd1, 0x5f, 0xcc, 0x0d, 0xc8, 0xfb, 0x0b, 0x07, 0x5a, 0x72, 0xdb,
-	0x9c, 0xd9, 0x2a, 0x78, 0x38, 0xdb, 0x94, 0xb4, 0xea, 0x99, 0xda, 0x6c, 0x3d, 0x73, 0x0b, 0x16,
-	0xd5, 0xb1, 0x6c, 0x36, 0x5b, 0xba, 0x0a, 0xf7, 0x5c, 0xa5, 0xb9, 0xc9, 0x44, 0x45, 0x6b, 0xc7,
-	0x17, 0x63, 0xb1, 0x49, 0x58, 0x36, 0x8d, 0x02, 0x59, 0xe8, 0x76, 0x7c, 0x0d, 0x7a, 0x3f, 0xa9,
-	0xc1, 0xf2, 0x7e, 0x96, 0x8c, 0x19, 0x1f, 0xb2, 0x49, 0xbe, 0x97, 0xf2, 0x7c, 0xee, 0x93, 0xc0,
-	0x55, 0xe8, 0xe0, 0x5c, 0x79, 0x5a, 0x66, 0xb4, 0x12, 0x81, 0x6f, 0xf3, 0xc9, 0x51, 0x7e, 0x92,
-	0x73, 0x36, 0x56, 0xea, 0x94, 0x88, 0x22, 0x53, 0x35, 0xec, 0x3c, 0x3c, 0x64, 0xa3, 0x54, 0x69,
-	0x22, 0xc6, 0x64, 0x0f, 0x16, 0x83, 0x24, 0xce, 0x79, 0x7f, 0x44, 0x8f, 0xd8, 0x28, 0x57, 0x9b,
-	0xe6, 0xdd, 0x99, 0x9e, 0xa5, 0xa9, 0x26, 0xde, 0xb2, 0x73, 0xbe, 0x2b, 0xc8, 0xe5, 0xd6, 0xe9,
-	0x06, 0x25, 0x86, 0xdc, 0x80, 0xae, 0x10, 0x25, 0xcc, 0x84, 0x05, 0x7a, 0x1d, 0x13, 0xb1, 0x40,
-	0xa1, 0x95, 0xf2, 0xb5, 0xcf, 0xc4, 0xc7, 0x43, 0x4b, 0xc2, 0x57, 0xda, 0x63, 0xff, 0x5a, 0x83,
-	0xcb, 0xa5, 0x46, 0xdb, 0x51, 0xce, 0x93, 0x41, 0x46, 0xc7, 0x5f, 0x9b, 0x05, 0x7f, 0xad, 0xd2,
-	0x82, 0x1f, 0x9d, 0x62, 0x41, 0x4b, 0xdf, 0x37, 0x98, 0xb2, 0x07, 0x0b, 0x47, 0x13, 0x71, 0x5b,
-	0x15, 0x66, 0x74, 0x7c, 0x0d, 0xce, 0x1a, 0xb9, 0xfd, 0x73, 0x37, 0xf2, 0x21, 0xac, 0x95, 0x3a,
-	0x1f, 0x4c, 0xc6, 0x63, 0x9a, 0x9d, 0xec, 0x1d, 0xfd, 0x90, 0x05, 0x3c, 0x9a, 0xce, 0x7f, 0xbb,
-	0x52, 0x92, 0x6b, 0xe2, 0xee, 0x63, 0x4b, 0xae, 0x0b, 0x9c, 0xfa, 0x5a, 0xfb, 0xd3, 0x3a, 0xac,
-	0xce, 0x8b, 0xfd, 0xba, 0x1c, 0xf7, 0xfd, 0x4a, 0xc7, 0xdd, 0x3b, 0xc5, 0x71, 0x86, 0xb6, 0x6f,
-	0x70, 0xdb, 0x13, 0x80, 0x44, 0x9b, 0x4a, 0x7a, 0xae, 0x7b, 0xf7, 0x5b, 0x6f, 0x90, 0xaa, 0xe9,
-	0x7d, 0x83, 0x15, 0x33, 0xe0, 0x98, 0xbe, 0xee, 0x63, 0x2e, 0x6b, 0xcb, 0xe6, 0xe5, 0x98, 0xbe,
-	0x7e, 0x38, 0x10, 0x8d, 0x53, 0x3c, 0x88, 0x74, 0x70, 0xe0, 0x2d, 0x76, 0xc9, 0x07, 0x3a, 0x60,
-	0x1b, 0x2a, 0x3e, 0x2e, 0x63, 0xe4, 0x1c, 0xf7, 0x03, 0x9a, 0xf6, 0x40, 0xbc, 0x6c, 0x1d, 0x4d,
-	0x8e, 0x37, 0x69, 0x3a, 0x1b, 0x38, 0xdd, 0x9f, 0x7b, 0xe0, 0xfc, 0xd8, 0xda, 0x9d, 0xba, 0x73,
-	0x25, 0x8b, 0xa9, 0xfb, 0xd0, 0x0e, 0x92, 0x89, 0x48, 0x5d, 0xaa, 0x20, 0xbd, 0x72, 0xc6, 0x39,
-	0xe3, 0x17, 0xc4, 0xe4, 0x1e, 0xb4, 0x06, 0x74, 0x32, 0x60, 0xba, 0xeb, 0x77, 0x26, 0x9b, 0x22,
-	0x25, 0x8f, 0x00, 0x86, 0x7a, 0xb3, 0xe9, 0x12, 0xfa, 0x1b, 0x3f, 0xcb, 0xae, 0xf4, 0x0d, 0x3e,
-	0xf2, 0x00, 0x43, 0x0d, 0x7d, 0x84, 0x55, 0x65, 0xa3, 0xe2, 0xd2, 0x55, 0x19, 0x21, 0x7e, 0xc9,
-	0xe4, 0xfd, 0xd8, 0x81, 0xa5, 0x5d, 0xf9, 0xd9, 0x59, 0x36, 0x4c, 0xed, 0x1e, 0x5c, 0x5d, 0xf7,
-	0xe0, 0xac, 0x8f, 0xd5, 0x62, 0xb7, 0x2b, 0x10, 0x83, 0x77, 0xcc, 0x68, 0xac, 0xf6, 0x92, 0x18,
-	0x63, 0x09, 0x37, 0x66, 0x61, 0x44, 0x63, 0xd5, 0x7a, 0x53, 0x10, 0xfa, 0x6a, 0xac, 0x1a, 0x5a,
-	0x8e, 0x8f, 0x43, 0x81, 0xa1, 0xaf, 0x45, 0x1f, 0x05, 0x31, 0xf4, 0xb5, 0x77, 0x00, 0x9d, 0xcd,
-	0x8d, 0xdd, 0x52, 0x78, 0x91, 0x23, 0xeb, 0x2a, 0x15, 0xf6, 0x60, 0x21, 0x18, 0xd2, 0x38, 0x66,
-	0x23, 0xb5, 0xa7, 0x35, 0x28, 0xfa, 0x8c, 0x59, 0x12, 0xb0, 0x3c, 0x57, 0xda, 0x68, 0xd0, 0xfb,
-	0x33, 0x07, 0x56, 0x0a, 0xa9, 0x67, 0x2e, 0xf4, 0x7d, 0x7b, 0xa1, 0xb3, 0x85, 0x41, 0x21, 0xa4,
-	0x34, 0x80, 0x07, 0x8b, 0xc7, 0x51, 0x96, 0xf3, 0xad, 0xf8, 0xe5, 0x84, 0x4d, 0xe4, 0x97, 0x82,
-	0xba, 0x6f, 0xe1, 0x90, 0x66, 0x97, 0xe6, 0xfc, 0x71, 0x14, 0x47, 0xf9, 0x90, 0x85, 0xaa, 0x1e,
-	0xb2, 0x70, 0xde, 0x6f, 0x01, 0xec, 0xb3, 0xec, 0x58, 0x69, 0xf7, 0x29, 0xc0, 0xe6, 0x46, 0x5f,
-	0xab, 0xe2, 0x54, 0xb4, 0x1a, 0x66, 0xd6, 0xe3, 0x1b, 0x66, 0xfb, 0x70, 0x76, 0x11, 0x6b, 0x33,
-	0x4d, 0x0a, 0x93, 0xaf, 0xf8, 0xed, 0xe0, 0x8f, 0xea, 0xb0, 0xb0, 0x4f, 0x4f, 0x46, 0x09, 0x0d,
-	0xc9, 0x35, 0x80, 0x8c, 0xbd, 0x9c, 0xb0, 0x9c, 0x97, 0xd5, 0x61, 0x47, 0x61, 0x64, 0x95, 0x1b,
-	0x88, 0xdd, 0x53, 0x7e, 0xbb, 0x68, 0x4b, 0x84, 0xa8, 0x72, 0x8d, 0x0f, 0xd1, 0xf2, 0xf2, 0xe0,
-	0xbd, 0xf9, 0x43, 0xb4, 0xf1, 0xe5, 0x99, 0x7c, 0x06, 0x6d, 0x1a, 0xca, 0xbf, 0x2e, 0xd4, 0x4d,
-	0xe2, 0x67, 0x11, 0x50, 0xf0, 0x90, 0x0f, 0x8a, 0x2b, 0x44, 0xf7, 0x4d, 0xe5, 0x99, 0xbe, 0x5d,
-	0xbc, 0x07, 0xad, 0x71, 0x5f, 0xc4, 0xda, 0xa2, 0xa8, 0xc7, 0x7a, 0x33, 0x1d, 0x4d, 0x51, 0x49,
-	0x89, 0x82, 0xac, 0x39, 0x3e, 0x54, 0xb7, 0x6d, 0x51, 0x50, 0x2d, 0x19, 0x05, 0xd5, 0x0d, 0xe8,
-	0x1e, 0xd1, 0xe0, 0x45, 0x5f, 0xde, 0x35, 0x7a, 0xab, 0xe2, 0xe6, 0x01, 0x88, 0x3a, 0x10, 0x18,
-	0x31, 0x8b, 0xb0, 0xba, 0x68, 0x03, 0xcd, 0x5e, 0xc3, 0x4a, 0xf7, 0xfb, 0x8a, 0x6c, 0xfd, 0x4b,
-	0x38, 0x3f, 0xf7, 0xc7, 0x11, 0xb9, 0x04, 0x64, 0x0e, 0xd9, 0x77, 0xcf, 0x91, 0x16, 0xd4, 0x76,
-	0x0f, 0x5d, 0x07, 0x9f, 0x4f, 0x0e, 0xdd, 0x9a, 0x80, 0xb7, 0xdc, 0xba, 0x80, 0xb7, 0xdc, 0x06,
-	0x3e, 0xb7, 0xbe, 0xe7, 0x36, 0xf1, 0xf9, 0x7c, 0xcb, 0x6d, 0xad, 0x3f, 0x30, 0xff, 0xc1, 0x91,
-	0x6b, 0x5a, 0xb6, 0x10, 0x28, 0x74, 0x19, 0xe0, 0xf9, 0x64, 0xbc, 0x77, 0xbc, 0x13, 0x4f, 0x93,
-	0x17, 0xae, 0x43, 0xba, 0xb0, 0xa0, 0xe2, 0xc7, 0xad, 0xad, 0x7f, 0x61, 0xa8, 0xa7, 0xff, 0xfd,
-	0xb0, 0xd4, 0xd3, 0x48, 0x94, 0xb4, 0x6a, 0x10, 0x2b, 0x83, 0xf6, 0x5d, 0x87, 0x5c, 0x80, 0x15,
-	0xfb, 0x17, 0xa1, 0xbe, 0x5b, 0x5b, 0xbf, 0x03, 0x9d, 0xe2, 0xa7, 0x16, 0x54, 0xa1, 0x00, 0x50,
-	0x50, 0x1b, 0x1a, 0x0f, 0xe3, 0x10, 0x79, 0x17, 0xa0, 0xbe, 0x97, 0x21, 0xfd, 0xef, 0x3b, 0xf6,
-	0x7f, 0x15, 0x85, 0x32, 0x6f, 0xd9, 0xbf, 0x4b, 0x98, 0xfa, 0xf4, 0x6c, 0x16, 0x43, 0xa5, 0x4b,
-	0x40, 0xe6, 0xfe, 0x11, 0xe9, 0xbb, 0x35, 0x72, 0x0b, 0xae, 0x99, 0xf8, 0x87, 0xc7, 0x9c, 0x65,
-	0xc6, 0xa7, 0x90, 0xbe, 0x5b, 0x5f, 0xff, 0x7b, 0x07, 0x16, 0xcd, 0x9f, 0x0a, 0xc8, 0x79, 0x58,
-	0x32, 0x61, 0x9c, 0xf8, 0x12, 0x10, 0x8d, 0x12, 0xbf, 0x0d, 0x6c, 0x66, 0x34, 0x1f, 0xca, 0x69,
-	0x2d, 0xbc, 0xf8, 0x9d, 0xc0, 0xad, 0xa1, 0xe1, 0x6c, 0x7c, 0x96, 0xa4, 0x6e, 0x9d, 0xac, 0xc1,
-	0xa5, 0x42, 0xb2, 0xf5, 0xd3, 0x80, 0xcb, 0x2a, 0xde, 0xa9, 0x7f, 0x00, 0xdc, 0x63, 0xb2, 0x0a,
-	0xae, 0x7e, 0xb7, 0x9f, 0x45, 0x31, 0xdf, 0x4d, 0x06, 0xee, 0xbf, 0x2d, 0x10, 0x52, 0x2a, 0xba,
-	0x35, 0xa6, 0xd1, 0xc8, 0xfd, 0xf7, 0x85, 0xf5, 0xfb, 0xd0, 0xd6, 0xdf, 0xf2, 0xc9, 0x12, 0x74,
-	0xf4, 0x18, 0x17, 0xb1, 0x02, 0xdd, 0x87, 0x65, 0x83, 0x43, 0x05, 0x86, 0x68, 0x59, 0x60, 0x60,
-	0x7c, 0x57, 0xb8, 0x4c, 0x7d, 0x4b, 0x46, 0xda, 0x12, 0x42, 0x66, 0x80, 0xd6, 0x01, 0x0f, 0x93,
-	0x09, 0x77, 0x1d, 0x35, 0x66, 0x59, 0xe6, 0xd6, 0xd0, 0xb3, 0x8f, 0xa3, 0x11, 0x73, 0xeb, 0xeb,
-	0xdf, 0x03, 0x28, 0xbf, 0x97, 0xa2, 0x80, 0x12, 0x42, 0x01, 0x5d, 0x58, 0xd8, 0x94, 0x09, 0xd8,
-	0x75, 0x48, 0x07, 0x9a, 0x4f, 0x30, 0xad, 0xba, 0x35, 0x54, 0xb2, 0x48, 0x97, 0x6e, 0x1d, 0xc9,
-	0x54, 0xe2, 0x73, 0x1b, 0xeb, 0xbf, 0x09, 0x2b, 0x33, 0xdf, 0x2e, 0xc9, 0x45, 0x70, 0x67, 0x50,
-	0x2a, 0x50, 0x0d, 0xec, 0x41, 0x14, 0x0f, 0x46, 0xcc, 0x75, 0x66, 0x88, 0x0f, 0x38, 0xcd, 0xb8,
-	0x5b, 0x9b, 0xc1, 0xee, 0x08, 0x95, 0xea, 0xb8, 0x93, 0x0c, 0xec, 0x56, 0x1c, 0xba, 0x8d, 0xf5,
-	0x8d, 0xb2, 0xd1, 0xae, 0x23, 0xc3, 0x84, 0x71, 0xe6, 0x0e, 0x34, 0xf7, 0xf8, 0x50, 0x2c, 0x0a,
-	0xa0, 0xf5, 0x24, 0x19, 0xd1, 0x78, 0x20, 0xcd, 0xf2, 0x94, 0x4e, 0xa9, 0x5b, 0x5f, 0xff, 0x81,
-	0xf1, 0xdb, 0x44, 0xd1, 0xd0, 0x25, 0x97, 0xe1, 0xc2, 0x3c, 0x56, 0xad, 0xc4, 0x7e, 0x71, 0xc0,
-	0x33, 0x19, 0x68, 0x36, 0x1a, 0x21, 0xb7, 0xb6, 0xfe, 0xbb, 0x0e, 0x9c, 0x9f, 0xeb, 0x2e, 0x22,
-	0xf5, 0x1c, 0x12, 0x85, 0xdf, 0x80, 0x2b, 0x16, 0xfe, 0x40, 0x5e, 0x1e, 0xb7, 0x69, 0x1c, 0x8e,
-	0xc4, 0x12, 0xde, 0x82, 0x55, 0x8b, 0xe0, 0xf1, 0x24, 0x16, 0xe1, 0xe5, 0xd6, 0xc8, 0x15, 0xb8,
-	0x6c, 0xcb, 0x1c, 0x46, 0x59, 0xb8, 0x4f, 0x33, 0x7e, 0xe2, 0xd6, 0xd7, 0x9f, 0xa2, 0xb7, 0x8b,
-	0x03, 0x97, 0xb8, 0xb0, 0x68, 0x80, 0x38, 0xf3, 0x05, 0x58, 0xd1, 0xbb, 0xd5, 0x97, 0x39, 0x49,
-	0xba, 0xa7, 0x44, 0xe6, 0x69, 0x12, 0xe7, 0xcc, 0xad, 0xad, 0x3f, 0x00, 0x28, 0x2f, 0xd3, 0x22,
-	0x68, 0x83, 0x99, 0xd3, 0x4d, 0x22, 0x0e, 0x58, 0x1c, 0xba, 0x0e, 0xfa, 0x44, 0xc2, 0x3e, 0x0b,
-	0x58, 0x34, 0x65, 0x6e, 0x6d, 0x63, 0xe1, 0xd7, 0x9b, 0xe2, 0x37, 0xd3, 0xa3, 0x96, 0x78, 0xdc,
-	0xfb, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0xac, 0x7f, 0x9b, 0x82, 0x2a, 0x00, 0x00,
+	// 4816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x4b, 0x6c, 0x24, 0xd7,
+	0x71, 0xdb, 0xd3, 0x33, 0xc3, 0x99, 0x9a, 0x21, 0xd9, 0x7c, 0xfb, 0x9b, 0xe5, 0x4a, 0xda, 0x75,
+	0xc7, 0x92, 0x56, 0x23, 0x7b, 0x2d, 0x71, 0x25, 0xaf, 0x22, 0xc5, 0xb2, 0x96, 0x5c, 0xee, 0x92,
+	0x32, 0x77, 0x49, 0x3d, 0x52, 0x5a, 0x23, 0x76, 0x32, 0x78, 0xd3, 0xfd, 0x38, 0xd3, 0xde, 0x9e,
+	0xee, 0x56, 0x77, 0x0f, 0x77, 0xe9, 0x00, 0x49, 0x00, 0x3b, 0x1f, 0xe4, 0x62, 0x24, 0x37, 0x1f,
+	0x12, 0x24, 0x41, 0x2e, 0xce, 0x25, 0xc8, 0x21, 0x0a, 0x10, 0x23, 0x40, 0xae, 0x46, 0x2e, 0x41,
+	0x3e, 0xf7, 0x20, 0x37, 0x07, 0x39, 0xe4, 0x98, 0x20, 0x87, 0x04, 0xf5, 0x3e, 0xfd, 0x99, 0x69,
+	0x92, 0xbb, 0x89, 0x10, 0x9f, 0xf8, 0xaa, 0xa6, 0xea, 0x75, 0xbd, 0x7a, 0x55, 0xf5, 0xaa, 0xea,
+	0x3d, 0xc2, 0x8a, 0x13, 0x06, 0x29, 0x7f, 0x9a, 0x0e, 0x86, 0xd3, 0xe4, 0x66, 0x14, 0x87, 0x69,
+	0x48, 0x3a, 0x05, 0x94, 0xfd, 0x87, 0x06, 0x58, 0x1b, 0x61, 0xe0, 0x7a, 0xa9, 0x17, 0x06, 0x0f,
+	0x78, 0x92, 0xb0, 0x11, 0x27, 0x37, 0xa1, 0x9e, 0x1e, 0x47, 0xbc, 0x67, 0x5c, 0x37, 0x6e, 0x2c,
+	0xad, 0xad, 0xde, 0x2c, 0xce, 0x91, 0x11, 0x1f, 0x1c, 0x47, 0x9c, 0x0a, 0x3a, 0x72, 0x13, 0x6a,
+	0x61, 0xd4, 0xab, 0x09, 0xea, 0x97, 0xaa, 0xa9, 0x77, 0x23, 0x1e, 0xb3, 0x34, 0x8c, 0x69, 0x2d,
+	0x8c, 0xc8, 0x05, 0x68, 0x1c, 0x31, 0x7f, 0xca, 0x7b, 0xe6, 0x75, 0xe3, 0x86, 0x49, 0x25, 0x40,
+	0x2e, 0x41, 0xf3, 0x89, 0x17, 0xb8, 0xe1, 0x93, 0x5e, 0x5d, 0xa0, 0x15, 0x64, 0x1f, 0xc1, 0x52,
+	0x36, 0xcd, 0x4e, 0x38, 0xf2, 0x1c, 0xd2, 0x2f, 0xc9, 0x77, 0xa9, 0xf4, 0x45, 0x41, 0x51, 0x90,
+	0xad, 0x0b, 0xc6, 0x63, 0x21, 0x9a, 0x49, 0x8d, 0xc7, 0xf8, 0x8d, 0x88, 0xc5, 0x3c, 0x48, 0x7b,
+	0xae, 0xfc, 0x86, 0x84, 0x08, 0x81, 0xba, 0xef, 0x25, 0x69, 0x8f, 0x5f, 0x37, 0x6f, 0x98, 0x54,
+	0x8c, 0xed, 0x3f, 0x33, 0x60, 0x31, 0xfb, 0xf0, 0xc3, 0xd0, 0xe5, 0x64, 0x4d, 0x7d, 0xf7, 0xd4,
+	0x95, 0x22, 0x65, 0xe1, 0xfb, 0xb7, 0x61, 0x61, 0x22, 0xd5, 0x2a, 0x56, 0xdb, 0x59, 0x7b, 0xb1,
+	0x9a, 0x4d, 0xe9, 0x9e, 0x6a, 0x6a, 0xf2, 0x26, 0x34, 0x7c, 0x5c, 0x8b, 0xd0, 0x46, 0x67, 0xed,
+	0x6a, 0x35, 0x9b, 0x58, 0x2e, 0x95, 0x94, 0xf6, 0xb7, 0x0a, 0x02, 0x1f, 0xc4, 0x9c, 0x93, 0x37,
+	0xa0, 0x11, 0x84, 0x2e, 0x4f, 0x7a, 0xc6, 0x75, 0xf3, 0x46, 0x67, 0x6d, 0xf5, 0x64, 0x89, 0xa9,
+	0x24, 0x24, 0x3d, 0x58, 0xf0, 0x39, 0x3b, 0xdc, 0xbe, 0x9b, 0xf4, 0x6a, 0x42, 0x17, 0x1a, 0xb4,
+	0xbf, 0x67, 0x00, 0xb9, 0x93, 0xa6, 0xb1, 0x37, 0x9c, 0xa6, 0x3c, 0xe3, 0x25, 0x2f, 0x43, 0x3d,
+	0x62, 0xe9, 0x58, 0xec, 0x45, 0x67, 0x6d, 0xa5, 0xf4, 0x85, 0x3d, 0x96, 0x8e, 0xa9, 0xf8, 0xf9,
+	0x14, 0x13, 0xc9, 0xe6, 0x2c, 0x99, 0xc8, 0x25, 0x68, 0x0a, 0xab, 0x48, 0x7a, 0xe6, 0x75, 0xf3,
+	0x46, 0x9b, 0x2a, 0xc8, 0xfe, 0x73, 0x03, 0xce, 0xef, 0xc5, 0x3c, 0xe6, 0x9f, 0x4e, 0xbd, 0xc4,
+	0x4b, 0xb9, 0x36, 0x59, 0x02, 0xf5, 0x80, 0x4d, 0xa4, 0x49, 0xb4, 0xa9, 0x18, 0x93, 0xdb, 0xd0,
+	0x76, 0xc2, 0xc0, 0x1d, 0xa4, 0x31, 0x97, 0x7b, 0x76, 0xa2, 0x06, 0x50, 0x59, 0xb4, 0x85, 0xc4,
+	0x38, 0x22, 0x6f, 0x43, 0x83, 0xa5, 0x69, 0x2c, 0xbf, 0xdd, 0x59, 0xbb, 0x56, 0x2d, 0x6f, 0xc6,
+	0x4d, 0x25, 0xf5, 0x49, 0xc6, 0x65, 0xff, 0x9e, 0x01, 0x2b, 0x45, 0x99, 0x37, 0x8f, 0x94, 0xc9,
+	0xcd, 0x49, 0x8c, 0xda, 0x67, 0x29, 0x0f, 0x9c, 0x63, 0x65, 0xb2, 0x1a, 0x2c, 0xaf, 0xc5, 0x7c,
+	0x8e, 0xb5, 0x9c, 0x24, 0xd4, 0x2f, 0xc1, 0x85, 0xa2, 0x4c, 0xfb, 0xfc, 0xd3, 0x29, 0x0f, 0x1c,
+	0x8e, 0xbe, 0x89, 0xa2, 0x48, 0x93, 0x69, 0x53, 0x09, 0x90, 0xcb, 0xb0, 0x30, 0x61, 0x4f, 0x07,
+	0x23, 0x16, 0x29, 0xc1, 0x9a, 0x13, 0xf6, 0xf4, 0x3e, 0x8b, 0x4e, 0x9c, 0xfe, 0xb7, 0x66, 0xf6,
+	0x69, 0x2f, 0x0e, 0x0f, 0x3d, 0x5f, 0xec, 0x53, 0x66, 0x2e, 0xed, 0x33, 0x6d, 0xe3, 0x19, 0xc2,
+	0x87, 0x51, 0x08, 0x1f, 0x95, 0x92, 0x1c, 0x97, 0x95, 0xff, 0xff, 0x19, 0x41, 0xbe, 0x6f, 0x82,
+	0x55, 0xfc, 0xb6, 0x08, 0x22, 0x4b, 0x50, 0xf3, 0x5c, 0xf1, 0x61, 0x93, 0xd6, 0x3c, 0x97, 0xbc,
+	0x5d, 0x0a, 0x2a, 0x5f, 0x28, 0x3b, 0xd0, 0x0c, 0x73, 0x41, 0xaa, 0x77, 0x67, 0xe3, 0xca, 0xf5,
+	0x13, 0x39, 0xe7, 0x42, 0xcb, 0x2f, 0x40, 0x3b, 0x8a, 0xf9, 0x91, 0xb0, 0x43, 0x15, 0x5e, 0x5e,
+	0x3a, 0x91, 0x5b, 0x50, 0xd1, 0x9c, 0x81, 0xbc, 0xa5, 0x03, 0x53, 0xe3, 0x0c, 0xce, 0x62, 0x6c,
+	0x22, 0x5f, 0x83, 0x56, 0xa2, 0x6c, 0xac, 0xd7, 0x14, 0x8c, 0x27, 0x2f, 0x55, 0x1b, 0x23, 0xcd,
+	0x58, 0x70, 0xb9, 0x91, 0x34, 0xa1, 0xde, 0xc2, 0x19, 0xcb, 0x55, 0xa6, 0x46, 0x35, 0x83, 0xcd,
+	0xca, 0xbb, 0x20, 0xdc, 0xe2, 0x56, 0x39, 0x32, 0xbe, 0x78, 0xaa, 0xda, 0xcf, 0x0e, 0x8e, 0xbf,
+	0x69, 0xcc, 0xb8, 0x53, 0xc0, 0xa2, 0x64, 0x1c, 0xa6, 0xb9, 0xad, 0x1a, 0x82, 0x41, 0x02, 0xc4,
+	0x02, 0x93, 0x39, 0x8e, 0xd8, 0xf2, 0x16, 0xc5, 0x21, 0xd2, 0x39, 0x7e, 0xe8, 0x3c, 0xd6, 0x47,
+	0xa2, 0x00, 0x10, 0x1b, 0xc6, 0x2e, 0x8f, 0x7b, 0x75, 0xc9, 0x2d, 0x00, 0xb2, 0x5a, 0x50, 0x65,
+	0xe3, 0xba, 0x79, 0xa3, 0x95, 0xeb, 0xc9, 0xfe, 0x89, 0x01, 0x17, 0xab, 0x04, 0x49, 0xc8, 0x2e,
+	0xb4, 0x13, 0x0d, 0xa8, 0x55, 0xbf, 0x79, 0xf2, 0x0e, 0x68, 0xca, 0x9b, 0xd9, 0x68, 0x33, 0x48,
+	0xe3, 0x63, 0x9a, 0xcf, 0xb1, 0x3a, 0x80, 0xa5, 0xf2, 0x8f, 0xb8, 0xac, 0xc7, 0xfc, 0x58, 0xf9,
+	0x36, 0x0e, 0xc9, 0x6d, 0xbd, 0xfc, 0xda, 0x59, 0x5b, 0xae, 0x66, 0x52, 0x1a, 0x7a, 0xb7, 0xf6,
+	0x8e, 0x61, 0xff, 0xad, 0x01, 0xcb, 0xf7, 0xd8, 0xd4, 0x4f, 0xef, 0x72, 0x9f, 0x1d, 0xef, 0xb1,
+	0x98, 0x4d, 0xd0, 0x7b, 0x26, 0x89, 0xf6, 0x9e, 0x49, 0x42, 0xd6, 0xa1, 0xeb, 0x7a, 0x89, 0x8c,
+	0xc8, 0x5e, 0x18, 0x54, 0x46, 0x11, 0xc1, 0x7e, 0xb7, 0x40, 0x45, 0x4b, 0x3c, 0x28, 0xf6, 0xc4,
+	0x0b, 0x94, 0xe6, 0x71, 0x28, 0x30, 0xec, 0xa9, 0xca, 0x43, 0x70, 0x88, 0x6e, 0x9f, 0xa4, 0xae,
+	0xcb, 0x8f, 0x84, 0xd5, 0x9b, 0x54, 0x41, 0xb8, 0x43, 0xc9, 0x98, 0x45, 0xd2, 0xa6, 0x0d, 0x2a,
+	0x01, 0x0c, 0x06, 0x09, 0xe7, 0xae, 0x30, 0x55, 0x93, 0x8a, 0xb1, 0x7d, 0x11, 0xce, 0x1f, 0xc4,
+	0xec, 0xf0, 0xd0, 0x73, 0xd6, 0x99, 0xcf, 0x02, 0x87, 0x8b, 0x05, 0x15, 0xd0, 0x34, 0x9c, 0xa6,
+	0x5e, 0x30, 0x92, 0xe8, 0x5f, 0x81, 0xf6, 0xfe, 0x83, 0x83, 0x3d, 0xb9, 0x68, 0x02, 0x75, 0xe6,
+	0xba, 0xb1, 0x0e, 0x9a, 0x38, 0x46, 0xdc, 0x61, 0x1c, 0x4e, 0xc4, 0x82, 0xdb, 0x54, 0x8c, 0x51,
+	0x39, 0x69, 0xa8, 0x0e, 0xcc, 0x5a, 0x1a, 0xa2, 0xa1, 0x4c, 0x13, 0x1e, 0x8b, 0x63, 0xa6, 0x2e,
+	0xe8, 0x32, 0x18, 0x7f, 0x8b, 0x58, 0x92, 0x3c, 0x09, 0x63, 0x57, 0x2c, 0xa9, 0x4d, 0x33, 0xd8,
+	0xfe, 0x03, 0x03, 0xba, 0x8f, 0xf8, 0x70, 0x1c, 0x86, 0x8f, 0xa5, 0x00, 0x16, 0x98, 0xd3, 0xd8,
+	0xd7, 0x1b, 0x3b, 0x8d, 0x7d, 0xf2, 0x01, 0x2c, 0x8c, 0x39, 0x73, 0x79, 0x2c, 0x5d, 0xa1, 0xb3,
+	0xf6, 0x4a, 0x49, 0xe5, 0x45, 0xee, 0x9b, 0x5b, 0x92, 0x50, 0x1a, 0x90, 0x66, 0x5b, 0x7d, 0x17,
+	0xba, 0xc5, 0x1f, 0x2a, 0x8c, 0xe7, 0x42, 0xd1, 0x78, 0xda, 0x45, 0xcb, 0xf8, 0xa9, 0x01, 0xb0,
+	0x39, 0x61, 0x9e, 0x2f, 0xc5, 0xeb, 0x43, 0x3d, 0x99, 0xa4, 0x91, 0xca, 0x41, 0xca, 0xd1, 0x3c,
+	0xd3, 0x22, 0x15, 0x34, 0xe4, 0x16, 0x2c, 0x3c, 0x91, 0xc2, 0x29, 0x9b, 0xbc, 0x72, 0xa2, 0xe0,
+	0x54, 0x53, 0xa2, 0xe3, 0x27, 0xd3, 0xe1, 0x77, 0xb8, 0x93, 0x0a, 0x2b, 0x69, 0x53, 0x0d, 0xe2,
+	0x36, 0x0c, 0x43, 0xf7, 0x58, 0xa9, 0x57, 0x8c, 0xc9, 0xcf, 0xc1, 0xa2, 0xcb, 0xdd, 0x69, 0xc4,
+	0x07, 0x2a, 0x9f, 0x95, 0x26, 0xd3, 0x95, 0xc8, 0x47, 0x02, 0x47, 0x5e, 0x85, 0x65, 0x27, 0x64,
+	0x3e, 0x4f, 0x9c, 0x8c, 0xac, 0x29, 0xc8, 0x96, 0x34, 0x5a, 0x12, 0xda, 0x23, 0x58, 0xda, 0x9c,
+	0x78, 0xa9, 0x88, 0xbd, 0x99, 0x39, 0x54, 0x65, 0x0e, 0xfa, 0x38, 0x90, 0xda, 0xd2, 0x20, 0x79,
+	0x15, 0x1a, 0x78, 0xca, 0xea, 0x64, 0xa6, 0x22, 0x43, 0x93, 0xbf, 0xdb, 0x3f, 0x0f, 0x9d, 0x8d,
+	0x69, 0x92, 0x86, 0x93, 0x93, 0xbf, 0x22, 0x0f, 0x3f, 0x36, 0x49, 0xc4, 0x47, 0xba, 0x54, 0x41,
+	0xf6, 0xef, 0x18, 0xd0, 0xbd, 0xe7, 0xc5, 0x68, 0xbd, 0xa1, 0xef, 0x39, 0xc7, 0xe4, 0x3a, 0x74,
+	0xa2, 0x38, 0x1c, 0xb2, 0xa1, 0xe7, 0x7b, 0xa9, 0xdc, 0x54, 0x83, 0x16, 0x51, 0xe4, 0x2a, 0xb4,
+	0x31, 0xa3, 0x38, 0xf4, 0x62, 0x9e, 0xa8, 0xd3, 0xb5, 0x35, 0x61, 0x4f, 0xef, 0x21, 0x8c, 0xc6,
+	0xe9, 0x05, 0x29, 0x8f, 0x8f, 0x98, 0xaf, 0xdc, 0x32, 0x83, 0xc9, 0x35, 0xe8, 0xa4, 0x61, 0xca,
+	0x7c, 0xc5, 0x2a, 0x7d, 0x14, 0x04, 0x4a, 0x30, 0xdb, 0xff, 0x5d, 0x87, 0x15, 0xca, 0x99, 0x83,
+	0xbe, 0xbd, 0x11, 0x06, 0x87, 0xde, 0x68, 0x1a, 0x73, 0xf2, 0xe5, 0xd2, 0x89, 0x5f, 0xde, 0x74,
+	0x4d, 0x5d, 0x38, 0x5e, 0xbf, 0x0e, 0x90, 0x87, 0x9e, 0xde, 0xdf, 0x2f, 0x0b, 0x53, 0x79, 0xa1,
+	0xc4, 0x35, 0x13, 0x9a, 0xb6, 0xce, 0xd1, 0x02, 0x0b, 0xf9, 0x06, 0x2c, 0x95, 0xdd, 0xbd, 0xf7,
+	0x17, 0x56, 0xc5, 0xc1, 0x55, 0x11, 0x12, 0xb6, 0xce, 0xd1, 0x19, 0xd6, 0xc2, 0x64, 0x2a, 0x48,
+	0xf4, 0x3e, 0x3b, 0x65, 0xb2, 0x62, 0x20, 0x29, 0x4c, 0xa6, 0xd0, 0x78, 0xfa, 0x67, 0x06, 0xd5,
+	0xfb, 0xe1, 0x4a, 0x45, 0x75, 0x51, 0xb6, 0xb7, 0xad, 0x73, 0x34, 0x67, 0xc0, 0x92, 0x42, 0x78,
+	0x5e, 0xef, 0xaf, 0x2e, 0x0b, 0xce, 0xcb, 0x33, 0x9c, 0xda, 0x29, 0xb7, 0xce, 0x51, 0x49, 0x48,
+	0x6e, 0x41, 0x53, 0xda, 0x55, 0xef, 0x9f, 0x2e, 0x09, 0x96, 0x5e, 0x39, 0xc7, 0xcb, 0x6d, 0x6e,
+	0xeb, 0x1c, 0x55, 0xa4, 0xe4, 0x1d, 0x68, 0x45, 0x31, 0x2f, 0xa6, 0xbb, 0x27, 0x1f, 0xd1, 0x22,
+	0xe3, 0x5d, 0x88, 0x62, 0x31, 0x20, 0x6f, 0x42, 0x33, 0x12, 0x46, 0xa8, 0x32, 0x9b, 0xf2, 0x56,
+	0x17, 0xad, 0x94, 0x2a, 0x42, 0xcc, 0x6e, 0xdd, 0xf8, 0x78, 0x10, 0x4f, 0x03, 0xe1, 0xaa, 0x2d,
+	0xda, 0x74, 0xe3, 0x63, 0x3a, 0x0d, 0xc8, 0xeb, 0x50, 0x9f, 0x84, 0xae, 0x0c, 0xee, 0x4b, 0x6b,
+	0x97, 0x2b, 0x66, 0x7a, 0x80, 0xe9, 0x81, 0x20, 0x5a, 0x6f, 0x69, 0xe7, 0xb0, 0x37, 0xa1, 0x8e,
+	0x8e, 0x45, 0x5e, 0x2b, 0xd9, 0xdc, 0xc5, 0x39, 0xcf, 0x2b, 0xd8, 0x9b, 0xce, 0x8b, 0x6b, 0x22,
+	0x78, 0x8b, 0xb1, 0xbd, 0x5b, 0x2e, 0xb8, 0x94, 0x21, 0x57, 0xf9, 0xe5, 0xcb, 0x19, 0xf7, 0x69,
+	0x45, 0x98, 0xfd, 0x25, 0x20, 0x07, 0xde, 0x84, 0x27, 0x29, 0x9b, 0x44, 0xf9, 0x84, 0x97, 0xa0,
+	0x79, 0x18, 0xc6, 0x13, 0x96, 0xaa, 0x29, 0x15, 0x64, 0x7f, 0x19, 0xce, 0xef, 0xa7, 0xcc, 0x79,
+	0x7c, 0x10, 0x33, 0x87, 0x97, 0xc8, 0x93, 0x27, 0x5e, 0xea, 0xc8, 0x1c, 0xbe, 0x45, 0x15, 0x64,
+	0xff, 0xd8, 0x80, 0xc5, 0x7b, 0x9e, 0x5f, 0x96, 0x74, 0x2e, 0xd7, 0xbf, 0x02, 0xe8, 0xe5, 0x83,
+	0xc4, 0xfb, 0x2e, 0xd7, 0x25, 0xce, 0x84, 0x3d, 0xdd, 0xf7, 0xbe, 0xcb, 0x75, 0x8d, 0xa1, 0x33,
+	0x5a, 0x59, 0x63, 0xdc, 0x19, 0x71, 0xf4, 0x78, 0xfc, 0x61, 0xc8, 0x9c, 0xc7, 0xd3, 0x28, 0xf3,
+	0xf8, 0x09, 0x7b, 0xba, 0x2e, 0x31, 0x18, 0x2e, 0x9c, 0x70, 0x12, 0xc5, 0x3c, 0x49, 0xd4, 0x06,
+	0x66, 0x30, 0x79, 0x19, 0x96, 0x0e, 0xfd, 0x69, 0x32, 0x1e, 0x64, 0x01, 0x45, 0x86, 0xd9, 0x45,
+	0x81, 0xdd, 0x56, 0x48, 0xfb, 0xaf, 0x4d, 0xb0, 0x76, 0xc2, 0xd1, 0xc8, 0x0b, 0x46, 0xf9, 0x02,
+	0xbe, 0x06, 0xed, 0x54, 0xeb, 0x4b, 0x1d, 0x2e, 0xe5, 0x5a, 0x70, 0x5e, 0x9b, 0x34, 0xe7, 0x20,
+	0x1f, 0x00, 0x24, 0xa8, 0xc0, 0x14, 0x15, 0xd8, 0xab, 0x55, 0x38, 0x6c, 0x85, 0x7e, 0x69, 0x81,
+	0xe7, 0xd9, 0x0b, 0x51, 0xc5, 0x2b, 0xa9, 0xc9, 0x6b, 0x60, 0x86, 0x53, 0x99, 0xd9, 0xcf, 0x5a,
+	0xed, 0x4e, 0x38, 0xda, 0x9d, 0xa6, 0xc2, 0xf0, 0x90, 0x06, 0x5b, 0x3d, 0x22, 0xa9, 0x6e, 0x54,
+	0x94, 0x94, 0xa5, 0xdd, 0xa4, 0x82, 0x8e, 0xbc, 0x0d, 0x0b, 0x3c, 0x70, 0x42, 0xcc, 0x49, 0xa5,
+	0x53, 0x5c, 0x9d, 0x9d, 0x7e, 0x53, 0xfe, 0x2c, 0x3e, 0xa1, 0x69, 0xc9, 0xeb, 0xd0, 0xf0, 0xf9,
+	0x11, 0xf7, 0x7b, 0x0b, 0x15, 0xae, 0xb0, 0xcf, 0x8f, 0x78, 0xec, 0xa5, 0xc7, 0x54, 0xd2, 0x90,
+	0x35, 0x68, 0x4f, 0xbc, 0x60, 0x20, 0x19, 0x5a, 0xa7, 0x31, 0xb4, 0x26, 0x5e, 0xb0, 0x83, 0x64,
+	0xf6, 0x67, 0x06, 0x5c, 0x38, 0x60, 0x9e, 0x3f, 0xb7, 0x87, 0xb7, 0x61, 0xc1, 0x97, 0xb8, 0x9e,
+	0x51, 0x11, 0x47, 0x66, 0xe9, 0xa9, 0xa6, 0x16, 0x39, 0x9c, 0x1f, 0x3e, 0x51, 0x56, 0x2a, 0xc6,
+	0xe4, 0x36, 0x34, 0x79, 0x1c, 0x87, 0xcf, 0xde, 0x19, 0x50, 0xe4, 0x78, 0x3c, 0xeb, 0xf2, 0xa5,
+	0x2e, 0x0c, 0x54, 0x83, 0xf6, 0x0f, 0x6b, 0x60, 0xa1, 0x05, 0x94, 0x84, 0xc6, 0xac, 0x32, 0x65,
+	0x71, 0xaa, 0x5c, 0x4c, 0x02, 0x98, 0x21, 0xf1, 0xc0, 0xd5, 0x55, 0x03, 0x0f, 0x5c, 0x3c, 0x44,
+	0x93, 0x88, 0x05, 0x03, 0x11, 0x10, 0x64, 0x66, 0xd2, 0x42, 0xc4, 0x43, 0x0c, 0x0a, 0xaf, 0xc0,
+	0x32, 0x16, 0x6d, 0x03, 0x8e, 0x71, 0x7b, 0x50, 0x48, 0x02, 0x17, 0xb3, 0x5a, 0x4e, 0xd0, 0x65,
+	0x46, 0xd6, 0x78, 0x2e, 0x23, 0x2b, 0x5b, 0x77, 0xf3, 0x7f, 0x61, 0xdd, 0xd7, 0xa0, 0x23, 0x8b,
+	0x67, 0x29, 0x5c, 0x47, 0x08, 0x07, 0x12, 0x85, 0x92, 0xd9, 0x7f, 0x63, 0x80, 0xf5, 0x80, 0xa7,
+	0xb1, 0xe7, 0x24, 0xb9, 0x6e, 0x5e, 0x2f, 0x05, 0xd5, 0xb2, 0x75, 0x4b, 0xe2, 0x42, 0x58, 0xbd,
+	0x0c, 0x0b, 0x61, 0x94, 0x26, 0x03, 0xcf, 0xd5, 0x7d, 0x0b, 0x04, 0xb7, 0xdd, 0x2c, 0x8a, 0x9a,
+	0x85, 0x28, 0x7a, 0x55, 0x96, 0xc5, 0x45, 0x55, 0xe1, 0x21, 0x74, 0xf4, 0x7f, 0xd0, 0x92, 0xfd,
+	0xa7, 0x35, 0xb8, 0xb0, 0x3b, 0x4c, 0x30, 0xc8, 0x94, 0xf3, 0x91, 0x37, 0x4a, 0xcb, 0x28, 0x67,
+	0x16, 0x05, 0x86, 0x72, 0x27, 0x51, 0x5b, 0x72, 0xed, 0xb9, 0x2c, 0xf9, 0x36, 0x2c, 0xa4, 0xd2,
+	0xc2, 0x2a, 0x8f, 0xd2, 0x59, 0xeb, 0xa3, 0x9a, 0x5a, 0xf6, 0x2e, 0x85, 0xfa, 0x45, 0x01, 0x3a,
+	0xcb, 0x38, 0xbb, 0x35, 0x54, 0x53, 0x8b, 0x9e, 0x06, 0xe6, 0x08, 0x8d, 0x8a, 0xaa, 0xaf, 0xca,
+	0x4b, 0xa9, 0x20, 0xb7, 0xff, 0xb3, 0x06, 0xed, 0x5c, 0x43, 0x1b, 0xd0, 0x8e, 0x55, 0x62, 0xa6,
+	0x0b, 0xd6, 0x97, 0x67, 0xbb, 0x43, 0x92, 0x34, 0x4b, 0xe0, 0x74, 0x91, 0x9a, 0xf1, 0x91, 0x1d,
+	0xe8, 0x86, 0xb9, 0x36, 0x75, 0xb1, 0x72, 0xe3, 0x84, 0x79, 0x0a, 0x8a, 0x57, 0x53, 0x95, 0xb8,
+	0x8b, 0x89, 0x82, 0x59, 0x4c, 0x14, 0x56, 0xbf, 0x0d, 0x4b, 0x65, 0x19, 0x2a, 0xca, 0x99, 0xb7,
+	0xca, 0xb5, 0xf0, 0x4b, 0x95, 0x29, 0x68, 0xc1, 0x80, 0xb2, 0x72, 0x67, 0x75, 0x08, 0x2b, 0x73,
+	0x92, 0x3d, 0x6f, 0xb1, 0x5d, 0x65, 0x84, 0xc5, 0x92, 0xea, 0x15, 0x80, 0x8d, 0xbd, 0x8f, 0x75,
+	0x9b, 0x0e, 0xe3, 0x15, 0x8f, 0x1d, 0xcc, 0x10, 0x65, 0xee, 0xae, 0x41, 0x4c, 0xf5, 0xe1, 0x01,
+	0x9f, 0x68, 0xc2, 0x0b, 0xd0, 0x10, 0xa9, 0xb7, 0x20, 0xab, 0x53, 0x09, 0x90, 0x17, 0xa0, 0xcd,
+	0x8e, 0x98, 0xe7, 0xb3, 0xa1, 0x2f, 0xa5, 0xa9, 0xd3, 0x1c, 0x81, 0xbe, 0x37, 0x4d, 0xb8, 0x2b,
+	0x54, 0x58, 0xa7, 0x62, 0x8c, 0x05, 0x03, 0xfe, 0xdd, 0x53, 0x1f, 0xad, 0xcb, 0x82, 0xa1, 0x80,
+	0x92, 0x05, 0x2f, 0x97, 0x27, 0x55, 0x9d, 0x8a, 0xb1, 0xfd, 0x6f, 0x06, 0xc0, 0x43, 0x9e, 0x6a,
+	0x61, 0x5e, 0x80, 0xf6, 0xf0, 0x38, 0xe5, 0xc9, 0xbe, 0x96, 0xbb, 0x4e, 0x73, 0x44, 0xf6, 0x2b,
+	0xe5, 0xce, 0x91, 0x16, 0x2a, 0x43, 0x88, 0x8a, 0x85, 0x39, 0x8f, 0x79, 0x2a, 0xb9, 0xa5, 0x6c,
+	0x45, 0x54, 0x81, 0x42, 0xcc, 0x50, 0x2f, 0x51, 0x88, 0x39, 0x2e, 0x40, 0x83, 0xc7, 0xb1, 0x17,
+	0x28, 0x19, 0x25, 0x80, 0x09, 0x13, 0x9e, 0x02, 0xd3, 0x54, 0x04, 0xc9, 0x3a, 0x55, 0x10, 0xe2,
+	0xdd, 0x38, 0x8c, 0xbc, 0x40, 0x1c, 0x8a, 0x75, 0xaa, 0x20, 0xd4, 0x3d, 0x8e, 0x90, 0xa1, 0x25,
+	0x7e, 0xd0, 0x20, 0x36, 0x92, 0x97, 0xb7, 0x58, 0xec, 0x3e, 0x61, 0x71, 0xd6, 0x50, 0x7d, 0x0d,
+	0x4c, 0x27, 0x9a, 0xaa, 0xb3, 0xad, 0x1c, 0x0d, 0xf3, 0xfd, 0xa4, 0x48, 0x83, 0xa4, 0x13, 0x3e,
+	0xe9, 0xd5, 0x2a, 0x48, 0xf3, 0x1d, 0xa5, 0x48, 0x83, 0xa4, 0x01, 0x4f, 0x7b, 0x66, 0x05, 0x69,
+	0xae, 0x6f, 0x8a, 0x34, 0xf6, 0xbf, 0xd7, 0x00, 0x76, 0x58, 0x30, 0x9a, 0xb2, 0x11, 0xbf, 0x1f,
+	0xa2, 0xf4, 0x5b, 0x9c, 0x45, 0xfb, 0xc7, 0x89, 0xda, 0x01, 0x0d, 0xa2, 0xfe, 0x71, 0x78, 0xc7,
+	0xf7, 0x43, 0x47, 0xeb, 0x3f, 0x43, 0xe8, 0x5f, 0xb7, 0x83, 0x69, 0xc2, 0x95, 0xf6, 0x73, 0x04,
+	0x66, 0x78, 0xe2, 0x34, 0xc1, 0x69, 0xa5, 0xe2, 0x33, 0x98, 0xbc, 0x04, 0x20, 0xc6, 0x92, 0x55,
+	0xaa, 0xbe, 0x80, 0xc1, 0xdf, 0x1f, 0xec, 0x47, 0x2c, 0x90, 0xbf, 0xcb, 0x3d, 0x28, 0x60, 0x70,
+	0x6e, 0x01, 0xe1, 0xdc, 0x72, 0x27, 0x32, 0x18, 0xf7, 0xfc, 0xc1, 0x06, 0x73, 0xc6, 0x5c, 0x32,
+	0xcb, 0xfd, 0x28, 0xa2, 0x50, 0x6e, 0x09, 0x22, 0x7b, 0x5b, 0xca, 0x9d, 0x21, 0x70, 0x8f, 0x77,
+	0x58, 0x92, 0xde, 0xdf, 0xe8, 0x71, 0xb9, 0xc7, 0x12, 0x42, 0xfc, 0x43, 0xfe, 0x14, 0xf1, 0x87,
+	0x12, 0x2f, 0x21, 0xf2, 0x45, 0x58, 0xbc, 0xbf, 0xb1, 0xb1, 0xf7, 0xf1, 0xbd, 0x58, 0x86, 0x83,
+	0xde, 0x48, 0x38, 0x42, 0x19, 0x69, 0x2f, 0x41, 0x57, 0x6b, 0xfc, 0x43, 0x76, 0xc4, 0xec, 0x1f,
+	0x19, 0xb0, 0xac, 0x11, 0xda, 0x2e, 0x4e, 0xab, 0x77, 0x35, 0x6d, 0xe1, 0x70, 0xe9, 0x43, 0x6d,
+	0x14, 0xea, 0x3a, 0xf7, 0x72, 0x25, 0xf5, 0xfd, 0x70, 0xeb, 0x1c, 0xad, 0x8d, 0x42, 0x3c, 0xba,
+	0xbe, 0xc3, 0x8e, 0x58, 0xef, 0x1f, 0x96, 0x2b, 0x0a, 0xac, 0xa2, 0x60, 0x5b, 0xe7, 0xa8, 0xa0,
+	0x5c, 0x6f, 0xc3, 0x82, 0x92, 0xcb, 0xfe, 0x3b, 0x03, 0x2e, 0x6c, 0x06, 0x47, 0x5e, 0x1c, 0x06,
+	0x13, 0x1e, 0xa4, 0xcc, 0x2f, 0x38, 0x6f, 0x39, 0xd9, 0x36, 0x8b, 0xb9, 0xf4, 0x3b, 0xd0, 0x1a,
+	0x2b, 0xcb, 0x57, 0x06, 0x5c, 0x3e, 0x32, 0x67, 0xdc, 0x82, 0x66, 0xd4, 0xc8, 0xe9, 0x2b, 0x99,
+	0x7a, 0x66, 0x05, 0xe7, 0x8c, 0xe2, 0x68, 0x46, 0x2d, 0xea, 0x97, 0x98, 0x1f, 0x89, 0xad, 0x33,
+	0xa9, 0x18, 0x23, 0x2e, 0xe0, 0x4f, 0x53, 0xb1, 0x6d, 0x26, 0x15, 0x63, 0xfb, 0x1a, 0xb4, 0x45,
+	0x36, 0xf5, 0x68, 0xcc, 0x03, 0x24, 0x40, 0xa9, 0xd5, 0x0a, 0xc4, 0xd8, 0xfe, 0x6d, 0x03, 0x96,
+	0xb2, 0x14, 0xe1, 0x13, 0xd1, 0x01, 0xbe, 0x55, 0xda, 0x9e, 0x13, 0xb2, 0x09, 0x41, 0x5a, 0xd8,
+	0x24, 0x0b, 0xcc, 0x24, 0x8d, 0x55, 0x83, 0x07, 0x87, 0xe4, 0x2b, 0xd8, 0x96, 0x8c, 0xa7, 0x4e,
+	0xb5, 0xab, 0x66, 0x13, 0x25, 0x54, 0x91, 0xd9, 0xbf, 0x6f, 0x00, 0xe4, 0x68, 0xf2, 0x8e, 0xce,
+	0x6a, 0xe4, 0xf9, 0x6a, 0x9f, 0xc0, 0x2e, 0x86, 0xea, 0x44, 0x94, 0x0c, 0xab, 0x1f, 0x03, 0xe4,
+	0xc8, 0x8a, 0xc3, 0xe8, 0xcd, 0xf2, 0x61, 0x74, 0xf5, 0x94, 0x15, 0x16, 0x8f, 0xa1, 0x0f, 0xa1,
+	0xbb, 0x81, 0xc5, 0x34, 0x4b, 0xf8, 0x76, 0x70, 0x18, 0x56, 0x56, 0xbb, 0x44, 0xd5, 0x2c, 0xba,
+	0xf5, 0xa9, 0xee, 0x95, 0x7c, 0x2f, 0xd0, 0x95, 0xa3, 0x18, 0x63, 0xb8, 0x04, 0xbd, 0x31, 0xa2,
+	0x03, 0x94, 0xad, 0xf5, 0x54, 0x55, 0x49, 0x2a, 0x0c, 0x1b, 0x33, 0xd5, 0x5b, 0x7b, 0xa6, 0x36,
+	0x6b, 0x61, 0x6d, 0x33, 0x64, 0x89, 0xb6, 0xab, 0x2b, 0x33, 0x59, 0x45, 0xbe, 0x0c, 0x9a, 0x91,
+	0xe2, 0xf9, 0xb9, 0x28, 0x84, 0xa2, 0xdc, 0x91, 0xed, 0xfc, 0xb5, 0x92, 0x29, 0x94, 0xd3, 0x82,
+	0x12, 0x65, 0xb9, 0x5d, 0x20, 0xd4, 0x52, 0x2b, 0xa8, 0xe5, 0x4d, 0xbc, 0x16, 0x90, 0x85, 0x51,
+	0xcf, 0x3c, 0xb5, 0x6a, 0xd2, 0x64, 0xf6, 0xaf, 0x1b, 0xd0, 0x15, 0x9f, 0xd0, 0xd7, 0xa8, 0xcf,
+	0xa9, 0xa3, 0xcf, 0xa1, 0xeb, 0xf8, 0x97, 0x06, 0x58, 0x3b, 0xde, 0x30, 0x66, 0xb1, 0xc7, 0x13,
+	0x2d, 0xc6, 0x87, 0xd0, 0xf6, 0x35, 0x4e, 0x99, 0xe6, 0x97, 0xca, 0x4e, 0x3b, 0xc3, 0x91, 0x23,
+	0x54, 0x06, 0x98, 0xb1, 0xaf, 0x3e, 0x82, 0xa5, 0xf2, 0x8f, 0x15, 0xc6, 0xfa, 0x95, 0xb2, 0xb1,
+	0x5e, 0x99, 0xdf, 0x03, 0xf5, 0x9d, 0xa2, 0xa9, 0xfe, 0x86, 0x91, 0xf9, 0x3d, 0x4b, 0xc9, 0x7b,
+	0xd0, 0x61, 0x51, 0xe4, 0x7b, 0x8e, 0x48, 0xb1, 0x7a, 0xc6, 0x59, 0x13, 0x15, 0xa9, 0xc9, 0x7b,
+	0xc5, 0xf5, 0x56, 0x26, 0xf7, 0x33, 0xeb, 0x2d, 0x2c, 0xd0, 0xfe, 0x47, 0x03, 0xce, 0x2b, 0x3b,
+	0xc1, 0x96, 0x07, 0x06, 0x55, 0x31, 0x69, 0x1f, 0xea, 0x4f, 0xc6, 0x3c, 0xa8, 0xec, 0x8a, 0x67,
+	0xf1, 0x8a, 0x0a, 0x1a, 0xdc, 0xf7, 0x27, 0xe8, 0x24, 0x95, 0xc9, 0x41, 0xee, 0x43, 0x54, 0x52,
+	0x91, 0xaf, 0x42, 0x2b, 0x56, 0x46, 0x59, 0x79, 0x19, 0x5d, 0x32, 0x5b, 0x9a, 0xd1, 0x4a, 0x91,
+	0x98, 0xbe, 0x73, 0xac, 0x14, 0x89, 0xa5, 0x54, 0xd0, 0xd8, 0xdb, 0x70, 0x7e, 0x4f, 0x94, 0x82,
+	0x1b, 0x63, 0xcf, 0x77, 0xf7, 0x42, 0xd1, 0xbf, 0x29, 0x5e, 0xb2, 0xcb, 0xf4, 0x42, 0x41, 0xa2,
+	0x07, 0x84, 0x84, 0x31, 0x0f, 0x44, 0x92, 0x5f, 0xa7, 0x19, 0x6c, 0xff, 0x71, 0x0d, 0xba, 0x78,
+	0xa2, 0x3f, 0xe0, 0x29, 0x73, 0x59, 0xca, 0x44, 0x3f, 0x9f, 0x4d, 0x22, 0x9f, 0xbb, 0xaa, 0xc2,
+	0xd6, 0x20, 0xb1, 0x61, 0x51, 0xb8, 0xf7, 0xc0, 0x73, 0x07, 0x63, 0x6f, 0x34, 0x56, 0x89, 0x4a,
+	0x47, 0x20, 0xb7, 0xdd, 0x2d, 0x6f, 0x34, 0x26, 0xd7, 0xa1, 0x9b, 0xd1, 0x60, 0x87, 0x40, 0x66,
+	0x2b, 0xa0, 0x48, 0x76, 0xc2, 0x27, 0x58, 0x27, 0x88, 0xba, 0xdc, 0x73, 0x55, 0xb6, 0xd2, 0x44,
+	0x70, 0x5b, 0x14, 0xec, 0xaa, 0xe4, 0xf5, 0x5c, 0x95, 0xaa, 0xb4, 0x24, 0x62, 0xdb, 0xc5, 0x3b,
+	0x95, 0x21, 0x1b, 0x8d, 0xd0, 0x9b, 0x9a, 0x15, 0x77, 0x2a, 0xc5, 0x15, 0xdc, 0x5c, 0x97, 0x84,
+	0xea, 0x4e, 0x45, 0xb1, 0xe1, 0x9d, 0x4a, 0xf1, 0x87, 0xe7, 0xba, 0x53, 0xf9, 0x9e, 0x0e, 0x4c,
+	0x99, 0x96, 0x2e, 0x42, 0x33, 0xe6, 0x9f, 0x0e, 0xd4, 0x6d, 0x75, 0x9d, 0x36, 0x62, 0xfe, 0xe9,
+	0xb6, 0x8b, 0x68, 0x7e, 0xc4, 0x75, 0x49, 0x8d, 0x69, 0xee, 0x11, 0xdf, 0x76, 0xc9, 0x1a, 0x98,
+	0x91, 0x13, 0xf5, 0x3a, 0x15, 0x8d, 0x80, 0x8a, 0x7d, 0xa4, 0x48, 0x8c, 0xf2, 0xf1, 0x24, 0xea,
+	0x75, 0xe5, 0x3d, 0x1b, 0x4f, 0x22, 0xfb, 0xbf, 0xb4, 0x53, 0xdd, 0x45, 0x09, 0xbe, 0x0a, 0x0d,
+	0xd1, 0xbd, 0xe8, 0x19, 0x15, 0xb3, 0x56, 0xd8, 0x3c, 0x95, 0xe4, 0x68, 0x9f, 0x13, 0xb5, 0x8a,
+	0xca, 0x87, 0x1f, 0xa5, 0x75, 0xd2, 0x8c, 0x96, 0xbc, 0x5f, 0x6a, 0x99, 0x08, 0xf6, 0xce, 0x49,
+	0xa6, 0x8a, 0x02, 0x16, 0x5a, 0x29, 0x77, 0x25, 0xff, 0xa2, 0xd8, 0xf7, 0xec, 0xe3, 0xdd, 0x8a,
+	0x30, 0x50, 0xdc, 0x47, 0xda, 0x4d, 0x0a, 0x90, 0xfd, 0x27, 0x06, 0x34, 0xa5, 0xdb, 0x9c, 0xda,
+	0xe6, 0xb8, 0x33, 0x7b, 0x5b, 0x51, 0xca, 0x9d, 0x6a, 0xb3, 0xb9, 0xd3, 0x17, 0xa0, 0xab, 0xc2,
+	0x72, 0xb1, 0x51, 0xd4, 0x51, 0xb8, 0x87, 0xea, 0x48, 0x9d, 0x4e, 0x95, 0xb5, 0xb6, 0xa9, 0x18,
+	0x0b, 0x27, 0xe1, 0xf1, 0x91, 0xe7, 0x70, 0x75, 0x41, 0xa8, 0x41, 0xfb, 0xb3, 0x1a, 0x2c, 0xed,
+	0xc5, 0xe1, 0x84, 0xa7, 0x63, 0x3e, 0x4d, 0x76, 0xa3, 0x34, 0x99, 0x7b, 0xd5, 0xf0, 0x02, 0xb4,
+	0xf1, 0x5b, 0x49, 0x94, 0x1f, 0x9e, 0x39, 0x02, 0x7f, 0x4d, 0xa6, 0xc3, 0xe4, 0x38, 0x49, 0xf9,
+	0x44, 0x89, 0x93, 0x23, 0xb2, 0xc3, 0xad, 0x5e, 0x3e, 0xf3, 0xc7, 0xdc, 0x8f, 0x94, 0x24, 0x62,
+	0x4c, 0x76, 0xa1, 0xeb, 0x84, 0x41, 0x92, 0x0e, 0x7c, 0x36, 0xe4, 0x7e, 0xd2, 0x6b, 0x56, 0x1c,
+	0x14, 0x65, 0x31, 0xb1, 0xd4, 0x4f, 0xd2, 0x1d, 0x41, 0x2e, 0x5d, 0xa7, 0xe3, 0xe4, 0x18, 0x6c,
+	0x48, 0x89, 0xa9, 0x84, 0x9a, 0xb0, 0x18, 0xc0, 0x5e, 0x3c, 0x08, 0x14, 0x6a, 0x29, 0x59, 0x7d,
+	0x5f, 0x3c, 0x96, 0x2b, 0xcd, 0xf0, 0x5c, 0x3e, 0xf6, 0x2f, 0x35, 0xb8, 0x9c, 0x4b, 0xb4, 0xe5,
+	0x25, 0x69, 0x38, 0x8a, 0xd9, 0xe4, 0x67, 0xa6, 0xc1, 0x6f, 0x56, 0x6a, 0xf0, 0xed, 0x13, 0x34,
+	0x58, 0x92, 0xf7, 0x0c, 0x55, 0xf6, 0x60, 0x61, 0x38, 0x15, 0x95, 0xb1, 0x50, 0xa3, 0x41, 0x35,
+	0x38, 0xab, 0xe4, 0xd6, 0xe7, 0xae, 0xe4, 0x03, 0x58, 0xcd, 0x65, 0xde, 0x9f, 0x4e, 0x26, 0x2c,
+	0x3e, 0xde, 0x15, 0xb7, 0xb5, 0xde, 0xd1, 0xfc, 0xf3, 0x1b, 0x35, 0x73, 0x4d, 0xd4, 0x59, 0xe5,
+	0x99, 0x8b, 0xcf, 0x8b, 0xec, 0x7f, 0x36, 0xe1, 0xe2, 0xfc, 0xb4, 0x3f, 0xab, 0x8d, 0xfb, 0xa4,
+	0x72, 0xe3, 0x6e, 0x9d, 0xb0, 0x71, 0x05, 0x69, 0xcf, 0xd8, 0xb6, 0xfb, 0x00, 0xa1, 0x56, 0x95,
+	0xdc, 0xb9, 0xce, 0xda, 0xab, 0x67, 0xcc, 0xaa, 0xe9, 0x69, 0x81, 0xb5, 0x78, 0x99, 0xd3, 0x9a,
+	0xbd, 0xcc, 0xc1, 0x40, 0xa4, 0x8d, 0x03, 0x2b, 0xe6, 0x45, 0x0a, 0x6c, 0xc4, 0xd7, 0x25, 0x06,
+	0x39, 0x87, 0xd3, 0xc3, 0x81, 0xc3, 0xa2, 0x1e, 0x88, 0x1f, 0x9b, 0xc3, 0xe9, 0xe1, 0x06, 0x8b,
+	0x66, 0x0d, 0xa7, 0xf3, 0xb9, 0x1b, 0xce, 0x0f, 0x4a, 0xde, 0xa9, 0xbb, 0x64, 0x32, 0x99, 0xba,
+	0x8d, 0xd9, 0xfe, 0x54, 0x1c, 0x5d, 0x2a, 0x21, 0xbd, 0x7a, 0x4a, 0x9c, 0xa1, 0x19, 0x31, 0xde,
+	0x7e, 0x8e, 0xd8, 0x74, 0xc4, 0x75, 0xeb, 0xf1, 0x54, 0x36, 0x45, 0x4a, 0xee, 0x02, 0x8c, 0xb5,
+	0xb3, 0xe9, 0x14, 0xfa, 0x8b, 0xcf, 0xe2, 0x95, 0xb4, 0xc0, 0x47, 0x3e, 0x40, 0x53, 0x9b, 0x4c,
+	0x64, 0x56, 0x59, 0xaf, 0x28, 0xf0, 0x2a, 0x2d, 0x84, 0xe6, 0x4c, 0xf6, 0x0f, 0x0c, 0x58, 0xdc,
+	0x91, 0x2f, 0x10, 0x65, 0xb3, 0xb7, 0xdc, 0xef, 0x33, 0x75, 0xbf, 0xaf, 0xf4, 0x6e, 0x51, 0x78,
+	0xbb, 0x02, 0xd1, 0x78, 0x27, 0x9c, 0x05, 0xca, 0x97, 0xc4, 0x18, 0x53, 0xb8, 0x09, 0x77, 0x3d,
+	0x16, 0xa8, 0x36, 0x9f, 0x82, 0xf4, 0x3b, 0x9c, 0x86, 0x74, 0xc5, 0xc2, 0x3b, 0x9c, 0xa6, 0xc2,
+	0xb0, 0xa7, 0xf6, 0x3e, 0xb4, 0x37, 0xd6, 0x77, 0xf2, 0xc9, 0xb3, 0x33, 0xd2, 0x54, 0x47, 0x61,
+	0x0f, 0x16, 0x9c, 0x31, 0x0b, 0x02, 0xee, 0x2b, 0x9f, 0xd6, 0xa0, 0xba, 0x83, 0x71, 0xf0, 0x92,
+	0x50, 0x4a, 0xa3, 0x41, 0xfb, 0x8f, 0x0c, 0x58, 0xde, 0x58, 0x7f, 0x96, 0x85, 0xbe, 0x51, 0x5e,
+	0xe8, 0x6c, 0x62, 0x90, 0x4d, 0x92, 0x2b, 0xc0, 0x86, 0xee, 0xa1, 0x17, 0x27, 0xe9, 0x66, 0xf0,
+	0xe9, 0x94, 0x4f, 0xe5, 0x2d, 0x87, 0x49, 0x4b, 0x38, 0xa4, 0xc1, 0xbe, 0xd0, 0x3d, 0x2f, 0xf0,
+	0x92, 0x31, 0x77, 0x55, 0x3e, 0x54, 0xc2, 0xd9, 0xbf, 0x06, 0xb0, 0xc7, 0xe3, 0x43, 0x25, 0xdd,
+	0x7b, 0x00, 0x1b, 0xeb, 0x03, 0x2d, 0x8a, 0x51, 0xd1, 0xd6, 0x98, 0x59, 0x0f, 0x2d, 0xa8, 0xed,
+	0xad, 0xd9, 0x45, 0xac, 0xce, 0x34, 0x44, 0x8a, 0x7c, 0x9a, 0xd4, 0xfe, 0x5d, 0x13, 0x16, 0xf6,
+	0xd8, 0xb1, 0x1f, 0x32, 0x97, 0xbc, 0x08, 0x80, 0xb7, 0xef, 0x3c, 0x49, 0xf3, 0xec, 0xb0, 0xad,
+	0x30, 0x32, 0xcb, 0x75, 0x84, 0xf7, 0xe4, 0xf7, 0x2e, 0x2d, 0x89, 0x10, 0x59, 0x6e, 0xe1, 0x1d,
+	0x9a, 0x2c, 0x1e, 0xec, 0xb3, 0xdf, 0xa1, 0x15, 0x1e, 0x9e, 0x91, 0xf7, 0xa1, 0xc5, 0x5c, 0x79,
+	0xc1, 0xd6, 0xab, 0x3f, 0xf3, 0x04, 0x19, 0x8f, 0x78, 0x21, 0x20, 0x4b, 0x88, 0xce, 0x59, 0xe9,
+	0x99, 0x22, 0xc4, 0x3e, 0xcb, 0x64, 0x20, 0x6c, 0xad, 0x2b, 0xf2, 0xb1, 0xde, 0x4c, 0xf7, 0x54,
+	0x64, 0x52, 0x22, 0x21, 0x6b, 0x4c, 0x0e, 0x54, 0x81, 0x2e, 0x12, 0xaa, 0xc5, 0x42, 0x42, 0x75,
+	0x0d, 0x3a, 0x78, 0x87, 0x3d, 0x90, 0xb5, 0x46, 0xef, 0xa2, 0xa8, 0x3c, 0x00, 0x51, 0xfb, 0x02,
+	0x23, 0xbe, 0x22, 0xb4, 0xde, 0xe3, 0x15, 0x65, 0x58, 0xbe, 0xfd, 0x54, 0x91, 0xf5, 0xbf, 0x05,
+	0x2b, 0x73, 0x4f, 0x64, 0xc9, 0x25, 0x20, 0x73, 0xc8, 0x81, 0x75, 0x8e, 0x34, 0xa1, 0xb6, 0x73,
+	0x60, 0x19, 0xf8, 0xf7, 0xfe, 0x81, 0x55, 0x13, 0xf0, 0xa6, 0x65, 0x0a, 0x78, 0xd3, 0xaa, 0xe3,
+	0xdf, 0xcd, 0x8f, 0xac, 0x06, 0xfe, 0x7d, 0xb8, 0x69, 0x35, 0xfb, 0x9f, 0x14, 0x5f, 0x93, 0xcb,
+	0x35, 0x2d, 0x95, 0x10, 0x38, 0xe9, 0x12, 0xc0, 0xc3, 0xe9, 0x64, 0xf7, 0x70, 0x3b, 0x38, 0x0a,
+	0x1f, 0x5b, 0x06, 0xe9, 0xc0, 0x82, 0xb2, 0x1f, 0xab, 0x46, 0x2e, 0x80, 0x95, 0xff, 0x28, 0x9f,
+	0x33, 0x59, 0x66, 0xff, 0x51, 0x41, 0x68, 0xfd, 0xa8, 0xb5, 0x24, 0xb4, 0x46, 0xe2, 0xfc, 0x17,
+	0x0b, 0xc4, 0x4a, 0xcd, 0x03, 0xcb, 0x20, 0xe7, 0x61, 0xb9, 0xfc, 0x04, 0x7e, 0x60, 0xd5, 0xfa,
+	0x3b, 0xd0, 0xce, 0xde, 0xee, 0xa2, 0x60, 0x19, 0x80, 0x13, 0xb5, 0xa0, 0x7e, 0x27, 0x70, 0x91,
+	0x77, 0x01, 0xcc, 0xdd, 0x78, 0x60, 0xd5, 0x10, 0xf5, 0x30, 0x4c, 0x07, 0x96, 0x89, 0xa3, 0x6f,
+	0xa2, 0x92, 0xea, 0x38, 0xfa, 0xc6, 0xee, 0xe1, 0xc0, 0x6a, 0xf4, 0x7f, 0x3c, 0xf3, 0xa4, 0x33,
+	0x13, 0xf5, 0x0a, 0x5c, 0xac, 0xc2, 0xe3, 0x47, 0x7a, 0x65, 0x96, 0x82, 0xc0, 0x97, 0x80, 0xcc,
+	0x3d, 0x8d, 0x45, 0x19, 0xbe, 0x00, 0x2f, 0x16, 0xf1, 0x77, 0x0e, 0x53, 0x1e, 0x17, 0xae, 0x6a,
+	0x50, 0xb8, 0x99, 0xef, 0xe9, 0xc7, 0xb1, 0x28, 0xed, 0xcc, 0xf7, 0x54, 0x0b, 0x13, 0xa5, 0xff,
+	0x65, 0x58, 0x99, 0x7b, 0x58, 0x8f, 0x42, 0xcc, 0x21, 0x51, 0x6c, 0x80, 0x26, 0xe2, 0x37, 0x3f,
+	0xb2, 0x0c, 0x3d, 0x7e, 0xb8, 0x69, 0xd5, 0xf4, 0x78, 0x3b, 0xb0, 0x4c, 0xb2, 0x08, 0x6d, 0x81,
+	0x0f, 0xd3, 0xed, 0xc0, 0xaa, 0xf7, 0xff, 0xc3, 0x80, 0x6e, 0xf1, 0xd9, 0x14, 0x59, 0x81, 0xc5,
+	0x22, 0x8c, 0xd3, 0x5e, 0x02, 0xa2, 0x51, 0xe2, 0x61, 0xd4, 0x46, 0xcc, 0x92, 0xb1, 0x65, 0xcc,
+	0xe1, 0xc5, 0x83, 0x29, 0xab, 0x86, 0x7b, 0x5d, 0xc6, 0xc7, 0x61, 0x64, 0x99, 0x64, 0x15, 0x2e,
+	0x65, 0x33, 0x97, 0x9e, 0x45, 0x59, 0xbc, 0xe2, 0x37, 0xf5, 0xca, 0xc9, 0x3a, 0x24, 0x97, 0xf2,
+	0xe9, 0xb2, 0xe7, 0x4b, 0xd6, 0x4f, 0x0c, 0x72, 0x11, 0x2c, 0x8d, 0xdf, 0x8b, 0xbd, 0x20, 0xdd,
+	0x09, 0x47, 0xd6, 0x4f, 0x17, 0x08, 0xc9, 0x17, 0x20, 0xde, 0x2d, 0x59, 0xff, 0xba, 0x40, 0xce,
+	0xe7, 0xd7, 0x7a, 0xf2, 0x5d, 0x92, 0xf5, 0xa3, 0x7b, 0xfd, 0x29, 0xac, 0xcc, 0xbd, 0x28, 0xc5,
+	0x35, 0xcd, 0x21, 0x51, 0x07, 0x16, 0x74, 0x05, 0xfe, 0xe3, 0xc0, 0xc3, 0x67, 0x35, 0x96, 0x41,
+	0x96, 0xa1, 0x23, 0x30, 0x0f, 0xf1, 0x95, 0x8d, 0x2f, 0xbd, 0x44, 0x20, 0x36, 0x9f, 0x46, 0x61,
+	0xc0, 0x83, 0xd4, 0x63, 0xbe, 0x65, 0x66, 0x64, 0x58, 0x3b, 0xa7, 0xa1, 0x55, 0xef, 0xef, 0x01,
+	0xe4, 0x4f, 0x8e, 0xf0, 0x67, 0x09, 0x89, 0xe7, 0x0f, 0xd2, 0x11, 0x25, 0x62, 0x17, 0x35, 0x63,
+	0xe0, 0x7e, 0x28, 0x02, 0x96, 0x3a, 0x63, 0xee, 0x5a, 0xb5, 0x9c, 0x64, 0xd3, 0x1d, 0x71, 0xcb,
+	0xec, 0xdf, 0x86, 0x96, 0x7e, 0x85, 0x84, 0xdb, 0xab, 0xc7, 0x28, 0xf6, 0x32, 0x74, 0xee, 0xe4,
+	0xfd, 0x29, 0xe5, 0xd7, 0xa2, 0xe3, 0x74, 0x6c, 0xd5, 0xfa, 0x5f, 0x07, 0xc8, 0xdf, 0x91, 0x20,
+	0x6d, 0x0e, 0x29, 0x73, 0xda, 0x4f, 0xdd, 0x70, 0x9a, 0x4a, 0x73, 0xda, 0x4f, 0x5d, 0x1e, 0xc7,
+	0xd2, 0xdf, 0xf0, 0x49, 0x89, 0x65, 0xf6, 0x1f, 0xc0, 0x52, 0xf9, 0xa5, 0x08, 0x3a, 0x74, 0x19,
+	0x83, 0x13, 0x2d, 0x42, 0xfb, 0xc3, 0xfd, 0xdd, 0x87, 0x3b, 0x5e, 0xc0, 0x13, 0x39, 0xd7, 0x4e,
+	0x38, 0x3a, 0x9c, 0xa4, 0x56, 0x0d, 0xe5, 0xc1, 0x5c, 0x2f, 0x14, 0xd3, 0x7d, 0x84, 0x57, 0x92,
+	0xfa, 0xe6, 0x1f, 0xe5, 0xc9, 0x21, 0x9c, 0x46, 0xd0, 0x8a, 0x74, 0xcc, 0x32, 0x48, 0x1b, 0x1a,
+	0xf7, 0x31, 0xc9, 0xb2, 0x6a, 0x38, 0x7d, 0x96, 0x3c, 0x59, 0x26, 0x92, 0xa9, 0x34, 0xc8, 0xaa,
+	0xf7, 0x7f, 0x15, 0x96, 0x67, 0x6e, 0xe1, 0x71, 0x9f, 0x66, 0x50, 0x2a, 0x40, 0x15, 0xb0, 0xfb,
+	0x5e, 0x30, 0xf2, 0x51, 0xfd, 0x65, 0xe2, 0xfd, 0x94, 0xc5, 0xa9, 0x55, 0x9b, 0xc1, 0x8a, 0xc7,
+	0x47, 0x96, 0x89, 0x71, 0xb5, 0x80, 0xdd, 0x0c, 0x5c, 0xab, 0xde, 0x5f, 0xcf, 0xaf, 0x78, 0xb4,
+	0x7b, 0x15, 0x61, 0xfc, 0x72, 0x1b, 0x1a, 0xbb, 0xe9, 0x58, 0x2c, 0x0a, 0xa0, 0x79, 0x3f, 0xc4,
+	0x7b, 0x0b, 0xa9, 0x65, 0xbc, 0x7b, 0xb1, 0xcc, 0xfe, 0xb7, 0x81, 0x94, 0x1b, 0xed, 0x07, 0xf2,
+	0x49, 0xc4, 0xf9, 0x79, 0xac, 0x5a, 0x49, 0xf9, 0x87, 0xfd, 0x34, 0x96, 0xde, 0x5a, 0x46, 0x23,
+	0x64, 0xd5, 0xfa, 0xdf, 0x37, 0x60, 0x65, 0xae, 0x3d, 0x8d, 0xd4, 0x73, 0x48, 0x9c, 0xfc, 0x1a,
+	0x5c, 0x2d, 0xe1, 0xf7, 0x65, 0x2b, 0x61, 0x8b, 0x05, 0xae, 0x2f, 0x96, 0x70, 0x05, 0x2e, 0x96,
+	0x08, 0xee, 0x4d, 0x03, 0xe1, 0x77, 0x56, 0x8d, 0x5c, 0x85, 0xcb, 0xe5, 0x39, 0xc7, 0x5e, 0xec,
+	0xee, 0xb1, 0x38, 0x3d, 0xb6, 0xcc, 0xbe, 0x03, 0x2d, 0xdd, 0xd8, 0xc6, 0x0d, 0xd5, 0x63, 0xfc,
+	0xe6, 0x0a, 0x2c, 0x6a, 0xf0, 0x2e, 0x1f, 0x4e, 0x47, 0x96, 0x81, 0xee, 0xa8, 0x51, 0xd8, 0x97,
+	0xb7, 0x6a, 0x45, 0xcc, 0x23, 0x16, 0x63, 0xa4, 0x2b, 0xb0, 0x6d, 0xe2, 0xeb, 0x1d, 0xab, 0xde,
+	0xff, 0x10, 0x3a, 0x2a, 0x96, 0x1f, 0xc8, 0x7b, 0x98, 0x6e, 0x01, 0xc4, 0x4f, 0x9d, 0x87, 0x65,
+	0x85, 0x19, 0x50, 0x99, 0x06, 0x49, 0x1b, 0xc8, 0x91, 0x49, 0x14, 0x06, 0x09, 0xb7, 0x6a, 0xfd,
+	0x0f, 0x00, 0xf2, 0xfe, 0x8d, 0x70, 0x34, 0x67, 0xe6, 0x40, 0x95, 0x88, 0x7d, 0x1e, 0xb8, 0xd2,
+	0x8f, 0x25, 0x4c, 0xb9, 0xc3, 0xbd, 0x23, 0x6e, 0xd5, 0xd6, 0x17, 0x7e, 0xb1, 0x21, 0xfe, 0x93,
+	0x6f, 0xd8, 0x14, 0x7f, 0x6e, 0xfd, 0xcf, 0x00, 0xe0, 0x3c, 0x1b, 0x21, 0xe5, 0x37, 0x00, 0x00,
 }
@@ -20,3 +20,22 @@ var Test_Condition_1_4_0_1 = NewConditionMessageNode(ConditionType_NumOfInvok, C
 var Test_Condition_C_1_0 = NewConditionMessageNode(ConditionType_NumOfInvok, ConditionOperator_GE, 1)
 
 var Test_Condition_C_2_0 = NewConditionMessageNode(ConditionType_NumOfInvok, ConditionOperator_GE, 0)
+
+// Test_Condition_2_2_0 latency >= 100ms
+var Test_Condition_2_2_0 = NewConditionMessageNode(ConditionType_Latency, ConditionOperator_GE, 100)
+
+// Test_Condition_4_0_0 number of invocations within the last 10s > 2
+var Test_Condition_4_0_0 = NewConditionWindowNode(ConditionOperator_GT, 2, 10000)
+
+var Test_Condition_5_2_0 = NewConditionLogicNode(LogicType_Not_, -1, []int64{1})
+
+// Test_Condition_5_2_0_0 number of invocation == 2
+var Test_Condition_5_2_0_0 = NewConditionMessageNode(ConditionType_NumOfInvok, ConditionOperator_EQ, 2)
+
+var Test_Condition_6_3_0 = NewConditionLogicNode(LogicType_Xor_, -1, []int64{1, 2})
+
+// Test_Condition_6_3_0_0 number of invocation >= 1
+var Test_Condition_6_3_0_0 = NewConditionMessageNode(ConditionType_NumOfInvok, ConditionOperator_GE, 1)
+
+// Test_Condition_6_3_0_1 number of invocation >= 3
+var Test_Condition_6_3_0_1 = NewConditionMessageNode(ConditionType_NumOfInvok, ConditionOperator_GE, 3)
@@ -19,3 +19,81 @@ var Test_PrerequisiteTree1 = &PrerequisiteTree{
 		NewPrerequisiteMessageNode(4, "EventC", NewConditionTree([]*ConditionNode{Test_Condition_1_4_0, Test_Condition_1_4_0_0, Test_Condition_1_4_0_1}, nil), 0, nil),
 	},
 }
+
+// Test_PrerequisiteTree2 (EventA = 1) && (latency(EventB, current) >= 100ms || latency(EventC, current) > 200ms)
+var Test_PrerequisiteTree2 = &PrerequisiteTree{
+	Nodes: []*PrerequisiteNode{
+		NewPrerequisiteLogicNode(0, LogicType_And_, -1, []int64{1, 2}),
+		NewPrerequisiteMessageNode(1, "EventA", NewConditionTree([]*ConditionNode{Test_Condition_0_2_0}, nil), 0, nil),
+		NewPrerequisiteLogicNode(2, LogicType_Or_, 0, []int64{3, 4}),
+		NewPrerequisiteAfterObservationNode(3, "EventB", 0, NewConditionTree([]*ConditionNode{Test_Condition_2_2_0}, nil), 2),
+		NewPrerequisiteAfterObservationNode(4, "EventC", 200, nil, 2),
+	},
+}
+
+// Test_PrerequisiteTree3 (EventA -> EventB, at most 1 other event in between) && (EventC)
+var Test_PrerequisiteTree3 = &PrerequisiteTree{
+	Nodes: []*PrerequisiteNode{
+		NewPrerequisiteLogicNode(0, LogicType_And_, -1, []int64{1, 2}),
+		NewPrerequisiteSequenceNode(1, []string{"EventA", "EventB"}, 1, 0),
+		NewPrerequisiteMessageNode(2, "EventC", nil, 0, nil),
+	},
+}
+
+// Test_PrerequisiteTree4 (EventW > 2 within the last 10s)
+var Test_PrerequisiteTree4 = &PrerequisiteTree{
+	Nodes: []*PrerequisiteNode{
+		NewPrerequisiteMessageNode(0, "EventW", NewConditionTree([]*ConditionNode{Test_Condition_4_0_0}, nil), -1, nil),
+	},
+}
+
+// Test_PrerequisiteTree5 (EventA) && !(EventB != 2)
+var Test_PrerequisiteTree5 = &PrerequisiteTree{
+	Nodes: []*PrerequisiteNode{
+		NewPrerequisiteLogicNode(0, LogicType_And_, -1, []int64{1, 2}),
+		NewPrerequisiteMessageNode(1, "EventA", NewConditionTree([]*ConditionNode{Test_Condition_6_3_0_0}, nil), 0, nil),
+		NewPrerequisiteLogicNode(2, LogicType_Not_, 0, []int64{3}),
+		NewPrerequisiteMessageNode(3, "EventB", NewConditionTree([]*ConditionNode{Test_Condition_5_2_0, Test_Condition_5_2_0_0}, nil), 2, nil),
+	},
+}
+
+// Test_PrerequisiteTree6 at least 2 of {(EventA), (EventB), (1 <= EventC < 3)}
+var Test_PrerequisiteTree6 = &PrerequisiteTree{
+	Nodes: []*PrerequisiteNode{
+		NewPrerequisiteKOfNode(0, 2, -1, []int64{1, 2, 3}),
+		NewPrerequisiteMessageNode(1, "EventA", NewConditionTree([]*ConditionNode{Test_Condition_6_3_0_0}, nil), 0, nil),
+		NewPrerequisiteMessageNode(2, "EventB", NewConditionTree([]*ConditionNode{Test_Condition_6_3_0_0}, nil), 0, nil),
+		NewPrerequisiteMessageNode(3, "EventC", NewConditionTree([]*ConditionNode{Test_Condition_6_3_0, Test_Condition_6_3_0_0, Test_Condition_6_3_0_1}, nil), 0, nil),
+	},
+}
+
+// Test_PrerequisiteTree7 (EventA) ^ (EventB)
+var Test_PrerequisiteTree7 = &PrerequisiteTree{
+	Nodes: []*PrerequisiteNode{
+		NewPrerequisiteLogicNode(0, LogicType_Xor_, -1, []int64{1, 2}),
+		NewPrerequisiteMessageNode(1, "EventA", NewConditionTree([]*ConditionNode{Test_Condition_6_3_0_0}, nil), 0, nil),
+		NewPrerequisiteMessageNode(2, "EventB", NewConditionTree([]*ConditionNode{Test_Condition_6_3_0_0}, nil), 0, nil),
+	},
+}
+
+// Test_PrerequisiteTree8 (EventA{rest.method = "POST"} >= 1) && (EventB{_.user_id in {"u1", "u2"}} >= 1)
+var Test_PrerequisiteTree8 = &PrerequisiteTree{
+	Nodes: []*PrerequisiteNode{
+		NewPrerequisiteLogicNode(0, LogicType_And_, -1, []int64{1, 2}),
+		NewPrerequisiteMessageAttrNode(1, "EventA", []*AttributeCondition{
+			NewAttributeCondition(NewPath(PathType_Library, []string{"rest", "method"}), AttributeOperator_AttrEQ, []string{"POST"}),
+		}, NewConditionTree([]*ConditionNode{Test_Condition_6_3_0_0}, nil), 0),
+		NewPrerequisiteMessageAttrNode(2, "EventB", []*AttributeCondition{
+			NewAttributeCondition(NewPath(PathType_Application, []string{"user_id"}), AttributeOperator_AttrIn, []string{"u1", "u2"}),
+		}, NewConditionTree([]*ConditionNode{Test_Condition_6_3_0_0}, nil), 0),
+	},
+}
+
+// Test_PrerequisiteTree9 (EventA >= 1) && (profile(hardware.cpu.percent) > 80)
+var Test_PrerequisiteTree9 = &PrerequisiteTree{
+	Nodes: []*PrerequisiteNode{
+		NewPrerequisiteLogicNode(0, LogicType_And_, -1, []int64{1, 2}),
+		NewPrerequisiteMessageNode(1, "EventA", NewConditionTree([]*ConditionNode{Test_Condition_6_3_0_0}, nil), 0, nil),
+		NewPrerequisiteProfileNode(2, "hardware.cpu.percent", ConditionOperator_GT, 80, 0),
+	},
+}
@@ -65,7 +65,7 @@ func (f *HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					tracer := background.ObservationBus.GetTracer()
 					reqCtx := cb_context.NewRequestContext("", atomic.AddUint64(&reqID, 1), cfgID, nil)
 					eveCtx := cb_context.NewEventContext(nil, cfg.InitializeSnapshots())
-					cbCtx := cb_context.NewContext(reqCtx, eveCtx).SetTracer(tracer)
+					cbCtx := cb_context.NewContext(reqCtx, eveCtx).SetTracer(tracer).SetContext(r.Context())
 
 					// todo: fake span metadata of caller
 					reqCtx.SetSpanMetadata(&cb.SpanMetadata{
@@ -78,26 +78,10 @@ func (f *HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					ctx := context.WithValue(r.Context(), cb_context.CB_CONTEXT_NAME, cbCtx)
 					r = r.WithContext(ctx)
 
-					// fmt.Printf("ContextBus ServeHTTP set ContextBus context: %+v\n", cbCtx)
-					ContextBus.OnSubmission(cbCtx, &cb.EventWhere{}, &cb.EventRecorder{
-						Type: cb.EventRecorderType_EventRecorderServiceHandler,
-						Name: r.URL.Path[1:] + ".1",
-					}, &cb.EventMessage{
-						Attrs:   nil,
-						Message: "ServeHTTP starts",
-						Paths:   nil,
-					})
-
-					f.f(w, r)
-
-					ContextBus.OnSubmission(cbCtx, &cb.EventWhere{}, &cb.EventRecorder{
-						Type: cb.EventRecorderType_EventRecorderServiceHandler,
-						Name: r.URL.Path[1:] + ".2",
-					}, &cb.EventMessage{
-						Attrs:   nil,
-						Message: "ServeHTTP ends",
-						Paths:   nil,
-					})
+					if err := f.serveContextBus(w, r, cbCtx); err != nil {
+						fmt.Printf("ContextBus ServeHTTP crashed, err: %v\n", err)
+						http.Error(w, err.Error(), http.StatusInternalServerError)
+					}
 
 					return
 				}
@@ -114,6 +98,45 @@ func (f *HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	f.f(w, r)
 }
 
+// serveContextBus calls f(w, r) between the start and end events of ServeHTTP.
+// Returns the error injected by ReactionFaultCrash.
+func (f *HandlerFunc) serveContextBus(w http.ResponseWriter, r *http.Request, cbCtx *cb_context.Context) (err error) {
+	defer ContextBus.RecoverFaultCrash(&err)
+
+	// fmt.Printf("ContextBus ServeHTTP set ContextBus context: %+v\n", cbCtx)
+	decision := ContextBus.OnSubmission(cbCtx, &cb.EventWhere{}, &cb.EventRecorder{
+		Type: cb.EventRecorderType_EventRecorderServiceHandler,
+		Name: r.URL.Path[1:] + ".1",
+	}, &cb.EventMessage{
+		Attrs:   nil,
+		Message: "ServeHTTP starts",
+		Paths:   nil,
+	})
+
+	if decision.IsDrop() {
+		// discard the request without response
+		panic(http.ErrAbortHandler)
+	} else if err := decision.GetErr(); err != nil {
+		// short-circuit the handler
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+
+		return nil
+	}
+
+	f.f(w, r)
+
+	ContextBus.OnSubmission(cbCtx, &cb.EventWhere{}, &cb.EventRecorder{
+		Type: cb.EventRecorderType_EventRecorderServiceHandler,
+		Name: r.URL.Path[1:] + ".2",
+	}, &cb.EventMessage{
+		Attrs:   nil,
+		Message: "ServeHTTP ends",
+		Paths:   nil,
+	})
+
+	return nil
+}
+
 // ServeMux is an HTTP request multiplexer.
 // It matches the URL of each incoming request against a list of registered
 // patterns and calls the handler for the pattern that